@@ -9,6 +9,7 @@ import (
 	"github.com/ignite/cli/ignite/chainconfig/config"
 	v0 "github.com/ignite/cli/ignite/chainconfig/v0"
 	v1 "github.com/ignite/cli/ignite/chainconfig/v1"
+	v2 "github.com/ignite/cli/ignite/chainconfig/v2"
 	"github.com/ignite/cli/ignite/pkg/xfilepath"
 )
 
@@ -24,21 +25,42 @@ var (
 	DefaultTSClientPath = "ts-client"
 
 	// LatestVersion defines the latest version of the config.
-	LatestVersion config.Version = 1
+	LatestVersion config.Version = 2
 
 	// Versions holds config types for the supported versions.
 	Versions = map[config.Version]config.Converter{
 		0: &v0.Config{},
 		1: &v1.Config{},
+		2: &v2.Config{},
 	}
 )
 
 // Config defines the latest config.
-type Config = v1.Config
+type Config = v2.Config
+
+// Validator defines the validator config of the latest version.
+type Validator = v2.Validator
+
+// Servers defines the validator servers config of the latest version.
+type Servers = v2.Servers
+
+// Hooks defines the lifecycle hooks config of the latest version.
+type Hooks = v2.Hooks
+
+// Token defines the token config of the latest version.
+type Token = v2.Token
+
+// TokenUnit defines the token unit config of the latest version.
+type TokenUnit = v2.TokenUnit
 
 // DefaultConfig returns a config for the latest version initialized with default values.
 func DefaultConfig() *Config {
-	return v1.DefaultConfig()
+	return v2.DefaultConfig()
+}
+
+// DefaultServers returns the validator servers config of the latest version with the default addresses.
+func DefaultServers() Servers {
+	return config.DefaultServers()
 }
 
 // FaucetHost returns the faucet host to use.
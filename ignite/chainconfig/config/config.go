@@ -46,7 +46,29 @@ type Build struct {
 	Main    string   `yaml:"main,omitempty"`
 	Binary  string   `yaml:"binary,omitempty"`
 	LDFlags []string `yaml:"ldflags,omitempty"`
-	Proto   Proto    `yaml:"proto"`
+
+	// Tags are the build tags used to build the binary, e.g. "ledger" or "netgo".
+	Tags []string `yaml:"tags,omitempty"`
+
+	// GCFlags are the arguments passed to the Go compiler.
+	// The arguments with the same package pattern are joined in one -gcflags flag, e.g. "all=-N -l".
+	GCFlags []string `yaml:"gcflags,omitempty"`
+
+	// ASMFlags are the arguments passed to the Go assembler.
+	// The arguments with the same package pattern are joined in one -asmflags flag.
+	ASMFlags []string `yaml:"asmflags,omitempty"`
+
+	// TrimPath removes the file system paths from the built binary.
+	TrimPath bool `yaml:"trimpath,omitempty"`
+
+	// CGO enables or disables cgo, the Go default is used when it's not defined.
+	CGO *bool `yaml:"cgo,omitempty"`
+
+	// Env holds environment variables set when the binary is built,
+	// e.g. the path of a static library for the C linker.
+	Env map[string]string `yaml:"env,omitempty"`
+
+	Proto Proto `yaml:"proto"`
 }
 
 // Proto holds proto build configs.
@@ -1,9 +1,11 @@
-package v1
+package config
 
 import (
 	"fmt"
 
 	"github.com/mitchellh/mapstructure"
+
+	"github.com/ignite/cli/ignite/pkg/xnet"
 )
 
 var (
@@ -167,3 +169,84 @@ func mergeMaps(src, dst map[string]interface{}) map[string]interface{} {
 
 	return dst
 }
+
+// UpdateValidatorAddresses increments the ports of the default server
+// addresses of each validator to avoid port clashing between them.
+func UpdateValidatorAddresses(validators []Validator) error {
+	// Margin to increase port numbers of the default addresses
+	margin := 10
+
+	for i := range validators {
+		// Use default addresses for the first validator
+		if i == 0 {
+			continue
+		}
+
+		validator := &validators[i]
+		servers, err := validator.GetServers()
+		if err != nil {
+			return err
+		}
+
+		servers, err = incrementDefaultServerPortsBy(servers, uint64(margin*i))
+		if err != nil {
+			return err
+		}
+
+		if err := validator.SetServers(servers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns a new server where the default addresses have their ports
+// incremented by a margin to avoid port clashing.
+func incrementDefaultServerPortsBy(s Servers, inc uint64) (Servers, error) {
+	var err error
+
+	if s.GRPC.Address == DefaultGRPCAddress {
+		s.GRPC.Address, err = xnet.IncreasePortBy(DefaultGRPCAddress, inc)
+		if err != nil {
+			return Servers{}, err
+		}
+	}
+
+	if s.GRPCWeb.Address == DefaultGRPCWebAddress {
+		s.GRPCWeb.Address, err = xnet.IncreasePortBy(DefaultGRPCWebAddress, inc)
+		if err != nil {
+			return Servers{}, err
+		}
+	}
+
+	if s.API.Address == DefaultAPIAddress {
+		s.API.Address, err = xnet.IncreasePortBy(DefaultAPIAddress, inc)
+		if err != nil {
+			return Servers{}, err
+		}
+	}
+
+	if s.P2P.Address == DefaultP2PAddress {
+		s.P2P.Address, err = xnet.IncreasePortBy(DefaultP2PAddress, inc)
+		if err != nil {
+			return Servers{}, err
+		}
+	}
+
+	if s.RPC.Address == DefaultRPCAddress {
+		s.RPC.Address, err = xnet.IncreasePortBy(DefaultRPCAddress, inc)
+		if err != nil {
+			return Servers{}, err
+		}
+	}
+
+	if s.RPC.PProfAddress == DefaultPProfAddress {
+		s.RPC.PProfAddress, err = xnet.IncreasePortBy(DefaultPProfAddress, inc)
+		if err != nil {
+			return Servers{}, err
+		}
+	}
+
+	return s, nil
+}
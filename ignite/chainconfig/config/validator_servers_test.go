@@ -1,17 +1,17 @@
-package v1_test
+package config_test
 
 import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
-	v1 "github.com/ignite/cli/ignite/chainconfig/v1"
+	"github.com/ignite/cli/ignite/chainconfig/config"
 	xyaml "github.com/ignite/cli/ignite/pkg/yaml"
 )
 
 func TestValidatorGetServers(t *testing.T) {
 	// Arrange
-	want := v1.DefaultServers()
+	want := config.DefaultServers()
 	want.RPC.Address = "127.0.0.0:1"
 	want.P2P.Address = "127.0.0.0:2"
 	want.GRPC.Address = "127.0.0.0:3"
@@ -19,7 +19,7 @@ func TestValidatorGetServers(t *testing.T) {
 	want.RPC.PProfAddress = "127.0.0.0:5"
 	want.API.Address = "127.0.0.0:6"
 
-	v := v1.Validator{
+	v := config.Validator{
 		App: map[string]interface{}{
 			"grpc":     map[string]interface{}{"address": want.GRPC.Address},
 			"grpc-web": map[string]interface{}{"address": want.GRPCWeb.Address},
@@ -44,8 +44,8 @@ func TestValidatorGetServers(t *testing.T) {
 
 func TestValidatorSetServers(t *testing.T) {
 	// Arrange
-	v := v1.Validator{}
-	s := v1.DefaultServers()
+	v := config.Validator{}
+	s := config.DefaultServers()
 	wantApp := xyaml.Map{
 		"grpc":     map[string]interface{}{"address": s.GRPC.Address},
 		"grpc-web": map[string]interface{}{"address": s.GRPCWeb.Address},
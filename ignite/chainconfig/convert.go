@@ -30,11 +30,35 @@ func ConvertLatest(c config.Converter) (_ *Config, err error) {
 }
 
 // MigrateLatest migrates a config file to the latest version.
+// The environment variable references of the config file are not replaced
+// and the comments are kept for the fields that exist in the latest version.
+// Nothing is written when the migrated config is not valid.
 func MigrateLatest(current io.Reader, latest io.Writer) error {
-	cfg, err := Parse(current)
+	data, err := io.ReadAll(current)
 	if err != nil {
 		return err
 	}
 
-	return yaml.NewEncoder(latest).Encode(cfg)
+	// the config is validated with the values of the environment variables
+	if _, err := parseLayers(configLayer{data: data}); err != nil {
+		return err
+	}
+
+	cfg, err := decodeLayers(configLayer{data: data})
+	if err != nil {
+		return err
+	}
+
+	out, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	if out, err = copyYAMLComments(data, out); err != nil {
+		return err
+	}
+
+	_, err = latest.Write(out)
+
+	return err
 }
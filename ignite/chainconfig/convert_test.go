@@ -2,6 +2,7 @@ package chainconfig_test
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -37,3 +38,46 @@ func TestMigrateLatest(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, want, latest.String())
 }
+
+func TestMigrateLatestKeepsComments(t *testing.T) {
+	// Arrange
+	current := strings.NewReader(`# Mars chain config
+version: 1
+accounts:
+# the faucet account
+- name: alice
+  coins: ["100token"] # initial balance
+validators:
+- name: alice
+  bonded: ${BONDED:-100token}
+`)
+	latest := bytes.Buffer{}
+
+	// Act
+	err := chainconfig.MigrateLatest(current, &latest)
+
+	// Assert
+	require.NoError(t, err)
+	require.Contains(t, latest.String(), "# Mars chain config\n")
+	require.Contains(t, latest.String(), "version: 2\n")
+	require.Contains(t, latest.String(), "# the faucet account\n")
+	require.Contains(t, latest.String(), "# initial balance\n")
+	require.Contains(t, latest.String(), "bonded: ${BONDED:-100token}\n")
+}
+
+func TestMigrateLatestInvalidConfig(t *testing.T) {
+	// Arrange
+	current := strings.NewReader(`version: 1
+accounts:
+- name: alice
+  coins: ["100token"]
+`)
+	latest := bytes.Buffer{}
+
+	// Act
+	err := chainconfig.MigrateLatest(current, &latest)
+
+	// Assert
+	require.EqualError(t, err, "config is not valid: at least one validator is required")
+	require.Empty(t, latest.String())
+}
@@ -0,0 +1,104 @@
+package chainconfig
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// DevPresetFast shortens the consensus timing and the governance, staking and slashing
+	// periods of the genesis, so these flows can be tested locally in minutes.
+	DevPresetFast = "fast"
+
+	// DevPresetDefault keeps the genesis values of the chain and uses a short consensus timing.
+	DevPresetDefault = "default"
+
+	// DevPresetRealistic keeps the genesis values of the chain and uses the consensus timing of Tendermint.
+	DevPresetRealistic = "realistic"
+)
+
+// DevPresetOverride is a value set by a dev preset.
+type DevPresetOverride struct {
+	// Path is the path of the value, e.g. app_state.gov.voting_params.voting_period
+	// for the genesis or consensus.timeout_commit for the Tendermint config.
+	Path string
+
+	// Value is the value set in the path.
+	Value interface{}
+}
+
+// DevPreset is a set of values for the genesis and the Tendermint config that
+// adapts the timing of a chain to a type of local development.
+type DevPreset struct {
+	Name string
+
+	// Genesis are the values set in the genesis when the chain is initialized.
+	// The values are only set when the genesis of the chain has them.
+	Genesis []DevPresetOverride
+
+	// Consensus are the values set in the Tendermint config of the nodes.
+	Consensus []DevPresetOverride
+}
+
+var devPresets = map[string]DevPreset{
+	DevPresetFast: {
+		Name: DevPresetFast,
+		Genesis: []DevPresetOverride{
+			{"app_state.gov.deposit_params.max_deposit_period", "60s"},
+			{"app_state.gov.voting_params.voting_period", "60s"},
+			{"app_state.staking.params.unbonding_time", "60s"},
+			{"app_state.slashing.params.signed_blocks_window", "20"},
+			{"app_state.slashing.params.downtime_jail_duration", "30s"},
+			{"app_state.mint.params.blocks_per_year", "63072000"},
+		},
+		Consensus: []DevPresetOverride{
+			{"consensus.timeout_commit", "500ms"},
+			{"consensus.timeout_propose", "500ms"},
+		},
+	},
+	DevPresetDefault: {
+		Name: DevPresetDefault,
+		Consensus: []DevPresetOverride{
+			{"consensus.timeout_commit", "1s"},
+			{"consensus.timeout_propose", "1s"},
+		},
+	},
+	DevPresetRealistic: {
+		Name: DevPresetRealistic,
+		Consensus: []DevPresetOverride{
+			{"consensus.timeout_commit", "5s"},
+			{"consensus.timeout_propose", "3s"},
+		},
+	},
+}
+
+// DevPresetNames returns the names of the dev presets.
+func DevPresetNames() []string {
+	names := make([]string, 0, len(devPresets))
+	for name := range devPresets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// GetDevPreset returns a dev preset by name, the default preset is returned when the name is empty.
+func GetDevPreset(name string) (DevPreset, error) {
+	if name == "" {
+		name = DevPresetDefault
+	}
+
+	p, ok := devPresets[name]
+	if !ok {
+		return DevPreset{}, fmt.Errorf("dev preset %q doesn't exist, use one of %v", name, DevPresetNames())
+	}
+
+	return p, nil
+}
+
+// ConfigDevPreset returns the dev preset selected in a config.
+func ConfigDevPreset(cfg *Config) (DevPreset, error) {
+	return GetDevPreset(cfg.DevPreset)
+}
@@ -13,12 +13,37 @@ var ErrConfigNotFound = errors.New("could not locate a config.yml in your chain"
 // ValidationError is returned when a configuration is invalid.
 type ValidationError struct {
 	Message string
+
+	// Path is the path of the config field with the invalid value, e.g. "validators[0].name".
+	Path string
+
+	// Layer is the config file that defines the invalid value when the config
+	// is defined in multiple layered files.
+	Layer string
+
+	// Line and Column are the position of the invalid value in the layer file.
+	// They are zero when the position is unknown.
+	Line, Column int
 }
 
 func (e *ValidationError) Error() string {
+	if e.Layer != "" {
+		return fmt.Sprintf("config is not valid: %s (defined in %s)", e.Message, e.Layer)
+	}
+
 	return fmt.Sprintf("config is not valid: %s", e.Message)
 }
 
+// Position returns the position of the invalid value in the config
+// files using the "file:line:column" format.
+func (e *ValidationError) Position() string {
+	if e.Line == 0 {
+		return e.Layer
+	}
+
+	return fmt.Sprintf("%s:%d:%d", e.Layer, e.Line, e.Column)
+}
+
 // UnsupportedVersionError is returned when the version of the config is not supported.
 type UnsupportedVersionError struct {
 	Version config.Version
@@ -0,0 +1,29 @@
+package chainconfig
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+)
+
+// envVarPattern matches the references to environment variables, e.g. ${HOME} or ${DENOM:-token}.
+// A reference is escaped by doubling its dollar sign, e.g. $${HOME} is kept as ${HOME}.
+var envVarPattern = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+// Interpolate replaces the references to environment variables in a config file with their values.
+// The default value of a reference, e.g. ${DENOM:-token}, is used when the variable is not set or
+// is empty. References to variables without a value nor a default value are replaced by an empty string.
+func Interpolate(data []byte) []byte {
+	return envVarPattern.ReplaceAllFunc(data, func(ref []byte) []byte {
+		if bytes.HasPrefix(ref, []byte("$$")) {
+			return ref[1:]
+		}
+
+		match := envVarPattern.FindSubmatch(ref)
+		if value := os.Getenv(string(match[1])); value != "" {
+			return []byte(value)
+		}
+
+		return match[2]
+	})
+}
@@ -2,9 +2,14 @@ package chainconfig
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/imdario/mergo"
+	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 
 	"github.com/ignite/cli/ignite/chainconfig/config"
@@ -14,46 +19,139 @@ import (
 // When the version of the file beign read is not the latest
 // it is automatically migrated to the latest version.
 func Parse(configFile io.Reader) (*Config, error) {
-	var buf bytes.Buffer
+	data, err := io.ReadAll(configFile)
+	if err != nil {
+		return DefaultConfig(), err
+	}
 
-	// Read the config file version first to know how to decode it
-	version, err := ReadConfigVersion(io.TeeReader(configFile, &buf))
+	return parseLayers(configLayer{data: data})
+}
+
+// ParseFile parses a config from a file path.
+func ParseFile(path string) (*Config, error) {
+	return ParseFiles(path)
+}
+
+// ParseFiles parses a config defined in multiple layered files.
+// The first file is the base config and the values defined in each of
+// the next files override the values defined in the previous ones.
+func ParseFiles(paths ...string) (*Config, error) {
+	layers, err := readLayers(paths...)
 	if err != nil {
 		return DefaultConfig(), err
 	}
 
-	// Decode the current config file version and assign default
-	// values for the fields that are empty
-	c, err := decodeConfig(&buf, version)
+	return parseLayers(layers...)
+}
+
+// ProfilePath returns the path of the config file of a profile.
+// The profile file is located next to the config file, e.g. config.ci.yml is
+// the file of the "ci" profile for the config.yml file.
+func ProfilePath(configPath, profile string) string {
+	ext := filepath.Ext(configPath)
+	return fmt.Sprintf("%s.%s%s", strings.TrimSuffix(configPath, ext), profile, ext)
+}
+
+// configLayer is a config file that overrides the values of the previous layers.
+type configLayer struct {
+	name string
+	data []byte
+}
+
+func readLayers(paths ...string) ([]configLayer, error) {
+	layers := make([]configLayer, len(paths))
+	for i, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		layers[i] = configLayer{name: path, data: data}
+	}
+
+	return layers, nil
+}
+
+func parseLayers(layers ...configLayer) (*Config, error) {
+	interpolateLayers(layers)
+
+	cfg, err := decodeLayers(layers...)
 	if err != nil {
 		return DefaultConfig(), err
 	}
 
+	if errs := validationErrors(cfg, false); len(errs) > 0 {
+		if len(layers) > 1 {
+			locateValidationError(errs[0], layers)
+		}
+
+		return cfg, errs[0]
+	}
+
+	return cfg, nil
+}
+
+// interpolateLayers replaces the environment variable references of the config layers.
+func interpolateLayers(layers []configLayer) {
+	for i := range layers {
+		layers[i].data = Interpolate(layers[i].data)
+	}
+}
+
+// decodeLayers decodes the config layers into a config of the latest version.
+func decodeLayers(layers ...configLayer) (*Config, error) {
+	// Read the config file version first to know how to decode it
+	version, err := ReadConfigVersion(bytes.NewReader(layers[0].data))
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode the current config file version
+	c, err := decodeConfig(bytes.NewReader(layers[0].data), version)
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the config values with the values of the other layers
+	for _, layer := range layers[1:] {
+		if err := mergeConfigLayer(c, layer, version); err != nil {
+			return nil, errors.Wrap(err, layer.name)
+		}
+	}
+
 	// Make sure that the empty fields contain default values
 	// after reading the config from the YAML file
 	if err = c.SetDefaults(); err != nil {
-		return DefaultConfig(), err
+		return nil, err
 	}
 
 	// Finally make sure the config is the latest one before validating it
-	cfg, err := ConvertLatest(c)
+	return ConvertLatest(c)
+}
+
+// mergeConfigLayer overrides the values of a config with the values defined in a layer.
+// The layer must have the same version than the config, which is the default when the
+// layer doesn't define a version.
+func mergeConfigLayer(c config.Converter, layer configLayer, version config.Version) error {
+	layerVersion, err := ReadConfigVersion(bytes.NewReader(layer.data))
+	if err == io.EOF {
+		// the layer is empty
+		return nil
+	}
 	if err != nil {
-		return DefaultConfig(), err
+		return err
 	}
 
-	return cfg, validateConfig(cfg)
-}
+	if layerVersion != 0 && layerVersion != version {
+		return fmt.Errorf("config version %d doesn't match the version %d of the base config", layerVersion, version)
+	}
 
-// ParseFile parses a config from a file path.
-func ParseFile(path string) (*Config, error) {
-	file, err := os.Open(path)
+	lc, err := decodeConfig(bytes.NewReader(layer.data), version)
 	if err != nil {
-		return DefaultConfig(), err
+		return err
 	}
 
-	defer file.Close()
-
-	return Parse(file)
+	return mergo.Merge(c, lc, mergo.WithOverride)
 }
 
 // ReadConfigVersion reads the config version.
@@ -84,27 +182,3 @@ func decodeConfig(r io.Reader, version config.Version) (config.Converter, error)
 
 	return cfg, nil
 }
-
-func validateConfig(c *Config) error {
-	if len(c.Accounts) == 0 {
-		return &ValidationError{"at least one account is required"}
-	}
-
-	if len(c.Validators) == 0 {
-		return &ValidationError{"at least one validator is required"}
-	}
-
-	for _, validator := range c.Validators {
-		if validator.Name == "" {
-			return &ValidationError{"validator 'name' is required"}
-		}
-
-		if validator.Bonded == "" {
-			return &ValidationError{"validator 'bonded' is required"}
-		}
-	}
-
-	// TODO: We should validate all of the required config fields
-
-	return nil
-}
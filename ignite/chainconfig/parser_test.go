@@ -3,6 +3,8 @@ package chainconfig_test
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -70,3 +72,86 @@ func TestParseWithUnknownVersion(t *testing.T) {
 	require.NotNil(t, want)
 	require.Equal(t, want.Version, version)
 }
+
+func TestParseFilesWithProfile(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yml")
+	profilePath := chainconfig.ProfilePath(configPath, "ci")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: 2
+build:
+  binary: marsd
+accounts:
+- name: alice
+  coins: ["100token"]
+validators:
+- name: alice
+  bonded: 100token
+`), 0o644))
+	require.NoError(t, os.WriteFile(profilePath, []byte(`accounts:
+- name: bob
+  coins: ["${BOB_COINS:-50token}"]
+`), 0o644))
+
+	// Act
+	cfg, err := chainconfig.ParseFiles(configPath, profilePath)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "config.ci.yml"), profilePath)
+	require.Equal(t, "marsd", cfg.Build.Binary)
+	require.Len(t, cfg.Accounts, 1)
+	require.Equal(t, "bob", cfg.Accounts[0].Name)
+	require.Equal(t, []string{"50token"}, cfg.Accounts[0].Coins)
+	require.Equal(t, "alice", cfg.Validators[0].Name)
+}
+
+func TestParseFilesValidationErrorLayer(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yml")
+	profilePath := chainconfig.ProfilePath(configPath, "devnet")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: 2
+accounts:
+- name: alice
+  coins: ["100token"]
+validators:
+- name: alice
+  bonded: 100token
+`), 0o644))
+	require.NoError(t, os.WriteFile(profilePath, []byte(`validators:
+- name: alice
+`), 0o644))
+
+	var want *chainconfig.ValidationError
+
+	// Act
+	_, err := chainconfig.ParseFiles(configPath, profilePath)
+
+	// Assert
+	require.ErrorAs(t, err, &want)
+	require.Equal(t, profilePath, want.Layer)
+	require.EqualError(t, err, fmt.Sprintf("config is not valid: validator 'bonded' is required (defined in %s)", profilePath))
+}
+
+func TestInterpolate(t *testing.T) {
+	// Arrange
+	t.Setenv("DENOM", "stake")
+	t.Setenv("EMPTY", "")
+
+	config := []byte(`coins: ["100${DENOM}", "5${EMPTY:-token}", "${MISSING}", "${MISSING:-}"]
+home: $HOME/.mars
+command: echo $${IGNITE_CHAIN_HOME}`)
+
+	want := `coins: ["100stake", "5token", "", ""]
+home: $HOME/.mars
+command: echo ${IGNITE_CHAIN_HOME}`
+
+	// Act
+	got := chainconfig.Interpolate(config)
+
+	// Assert
+	require.Equal(t, want, string(got))
+}
@@ -0,0 +1,101 @@
+package chainconfig
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+const jsonSchemaVersion = "http://json-schema.org/draft-07/schema#"
+
+// jsonSchema is the subset of the JSON Schema keywords used to describe the config.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties interface{}            `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Minimum              *int                   `json:"minimum,omitempty"`
+}
+
+// JSONSchema returns the JSON Schema of the latest config version.
+// The schema is generated from the YAML tags of the config types and
+// can be used by editors to validate and autocomplete config files.
+func JSONSchema() ([]byte, error) {
+	s := newJSONSchema(reflect.TypeOf(Config{}))
+	s.Schema = jsonSchemaVersion
+	s.Title = "Ignite CLI chain config"
+
+	return json.MarshalIndent(s, "", "  ")
+}
+
+func newJSONSchema(t reflect.Type) *jsonSchema {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return newJSONSchema(t.Elem())
+	case reflect.Struct:
+		s := &jsonSchema{
+			Type:                 "object",
+			Properties:           make(map[string]*jsonSchema),
+			AdditionalProperties: false,
+		}
+		addJSONSchemaProperties(s, t)
+		return s
+	case reflect.Map:
+		s := &jsonSchema{Type: "object"}
+		if t.Elem().Kind() != reflect.Interface {
+			s.AdditionalProperties = newJSONSchema(t.Elem())
+		}
+		return s
+	case reflect.Slice, reflect.Array:
+		return &jsonSchema{Type: "array", Items: newJSONSchema(t.Elem())}
+	case reflect.String:
+		return &jsonSchema{Type: "string"}
+	case reflect.Bool:
+		return &jsonSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &jsonSchema{Type: "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		min := 0
+		return &jsonSchema{Type: "integer", Minimum: &min}
+	case reflect.Float32, reflect.Float64:
+		return &jsonSchema{Type: "number"}
+	default:
+		// Any value is allowed
+		return &jsonSchema{}
+	}
+}
+
+// addJSONSchemaProperties adds the fields of a struct type as properties of an object schema.
+// Property names follow the YAML decoding rules, so the fields of inlined structs are added
+// as properties of the object and fields without name in the tag use the lowercased name.
+func addJSONSchemaProperties(s *jsonSchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if strings.Contains(","+opts+",", ",inline,") {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+
+			addJSONSchemaProperties(s, ft)
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+
+		s.Properties[name] = newJSONSchema(f.Type)
+	}
+}
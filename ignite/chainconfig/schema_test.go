@@ -0,0 +1,39 @@
+package chainconfig_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestJSONSchema(t *testing.T) {
+	// Act
+	data, err := chainconfig.JSONSchema()
+
+	// Assert
+	require.NoError(t, err)
+
+	var schema struct {
+		Type       string `json:"type"`
+		Properties map[string]struct {
+			Type  string `json:"type"`
+			Items struct {
+				Properties map[string]json.RawMessage `json:"properties"`
+			} `json:"items"`
+		} `json:"properties"`
+	}
+	require.NoError(t, json.Unmarshal(data, &schema))
+	require.Equal(t, "object", schema.Type)
+
+	// fields of the inlined base config are properties of the config
+	require.Equal(t, "integer", schema.Properties["version"].Type)
+	require.Equal(t, "object", schema.Properties["build"].Type)
+	require.Equal(t, "object", schema.Properties["genesis"].Type)
+
+	require.Equal(t, "array", schema.Properties["validators"].Type)
+	require.Contains(t, schema.Properties["validators"].Items.Properties, "bonded")
+	require.Contains(t, schema.Properties["validators"].Items.Properties, "gentx")
+}
@@ -7,13 +7,15 @@ import (
 	"github.com/ignite/cli/ignite/chainconfig/config"
 	v0testdata "github.com/ignite/cli/ignite/chainconfig/v0/testdata"
 	v1testdata "github.com/ignite/cli/ignite/chainconfig/v1/testdata"
+	v2testdata "github.com/ignite/cli/ignite/chainconfig/v2/testdata"
 )
 
 var Versions = map[config.Version][]byte{
 	0: v0testdata.ConfigYAML,
 	1: v1testdata.ConfigYAML,
+	2: v2testdata.ConfigYAML,
 }
 
 func GetLatestConfig(t *testing.T) *chainconfig.Config {
-	return v1testdata.GetConfig(t)
+	return v2testdata.GetConfig(t)
 }
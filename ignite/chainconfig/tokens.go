@@ -0,0 +1,97 @@
+package chainconfig
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+)
+
+var (
+	// denomRegexp matches the valid denoms of the Cosmos SDK coins.
+	denomRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9/:._-]{2,127}$`)
+
+	// coinRegexp matches a coin, e.g. 1000uatom, with the amount and the denom as groups.
+	coinRegexp = regexp.MustCompile(`^\s*([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z][a-zA-Z0-9/:._-]{2,127})\s*$`)
+)
+
+// ConfigToken returns the token of a config that has a denom.
+func ConfigToken(cfg *Config, denom string) (Token, bool) {
+	for _, t := range cfg.Tokens {
+		if t.Denom == denom {
+			return t, true
+		}
+	}
+
+	return Token{}, false
+}
+
+// TokenDenomMetadata returns the bank denom metadata of a token.
+// The units of the metadata are the base denom, the units of the token and
+// the display denom, sorted by exponent. The display denom and the symbol
+// default to the base denom and the name defaults to the display denom.
+func TokenDenomMetadata(t Token) genesis.DenomMetadata {
+	m := genesis.DenomMetadata{
+		Description: t.Description,
+		Base:        t.Denom,
+		Display:     t.Display,
+		Name:        t.Name,
+		Symbol:      t.Symbol,
+		URI:         t.URI,
+		DenomUnits:  []genesis.DenomUnit{{Denom: t.Denom, Exponent: 0}},
+	}
+
+	if m.Display == "" {
+		m.Display = t.Denom
+	}
+
+	if m.Name == "" {
+		m.Name = m.Display
+	}
+
+	if m.Symbol == "" {
+		m.Symbol = strings.ToUpper(m.Display)
+	}
+
+	hasDisplay := m.Display == t.Denom
+	for _, u := range t.Units {
+		m.DenomUnits = append(m.DenomUnits, genesis.DenomUnit{
+			Denom:    u.Denom,
+			Exponent: u.Exponent,
+			Aliases:  u.Aliases,
+		})
+
+		hasDisplay = hasDisplay || u.Denom == m.Display
+	}
+
+	if !hasDisplay {
+		m.DenomUnits = append(m.DenomUnits, genesis.DenomUnit{Denom: m.Display, Exponent: t.Exponent})
+	}
+
+	sort.SliceStable(m.DenomUnits, func(i, j int) bool {
+		return m.DenomUnits[i].Exponent < m.DenomUnits[j].Exponent
+	})
+
+	return m
+}
+
+// ConfigDenomMetadata returns the bank denom metadata of the tokens of a config.
+func ConfigDenomMetadata(cfg *Config) []genesis.DenomMetadata {
+	metadata := make([]genesis.DenomMetadata, len(cfg.Tokens))
+	for i, t := range cfg.Tokens {
+		metadata[i] = TokenDenomMetadata(t)
+	}
+
+	return metadata
+}
+
+// coinDenom returns the denom of a coin, false is returned when the coin is not valid.
+func coinDenom(coin string) (string, bool) {
+	m := coinRegexp.FindStringSubmatch(coin)
+	if m == nil {
+		return "", false
+	}
+
+	return m[2], true
+}
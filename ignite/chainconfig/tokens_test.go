@@ -0,0 +1,60 @@
+package chainconfig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+)
+
+func TestTokenDenomMetadata(t *testing.T) {
+	cases := []struct {
+		name  string
+		token chainconfig.Token
+		want  genesis.DenomMetadata
+	}{
+		{
+			name:  "base denom",
+			token: chainconfig.Token{Denom: "stake"},
+			want: genesis.DenomMetadata{
+				Base:       "stake",
+				Display:    "stake",
+				Name:       "stake",
+				Symbol:     "STAKE",
+				DenomUnits: []genesis.DenomUnit{{Denom: "stake"}},
+			},
+		},
+		{
+			name: "display denom and units",
+			token: chainconfig.Token{
+				Denom:    "uatom",
+				Display:  "atom",
+				Exponent: 6,
+				Symbol:   "ATOM",
+				Name:     "Cosmos Hub Atom",
+				Units: []chainconfig.TokenUnit{
+					{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+				},
+			},
+			want: genesis.DenomMetadata{
+				Base:    "uatom",
+				Display: "atom",
+				Name:    "Cosmos Hub Atom",
+				Symbol:  "ATOM",
+				DenomUnits: []genesis.DenomUnit{
+					{Denom: "uatom"},
+					{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+					{Denom: "atom", Exponent: 6},
+				},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, chainconfig.TokenDenomMetadata(tt.token))
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/ignite/cli/ignite/chainconfig/config"
-	"github.com/ignite/cli/ignite/pkg/xnet"
 )
 
 // DefaultConfig returns a config with default values.
@@ -30,7 +29,7 @@ func (c *Config) SetDefaults() error {
 	}
 
 	// Make sure that validator addresses don't chash with each other
-	if err := c.updateValidatorAddresses(); err != nil {
+	if err := config.UpdateValidatorAddresses(c.Validators); err != nil {
 		return err
 	}
 
@@ -55,82 +54,3 @@ func (c *Config) Decode(r io.Reader) error {
 
 	return nil
 }
-
-func (c *Config) updateValidatorAddresses() (err error) {
-	// Margin to increase port numbers of the default addresses
-	margin := 10
-
-	for i := range c.Validators {
-		// Use default addresses for the first validator
-		if i == 0 {
-			continue
-		}
-
-		validator := &c.Validators[i]
-		servers, err := validator.GetServers()
-		if err != nil {
-			return err
-		}
-
-		servers, err = incrementDefaultServerPortsBy(servers, uint64(margin*i))
-		if err != nil {
-			return err
-		}
-
-		if err := validator.SetServers(servers); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-// Returns a new server where the default addresses have their ports
-// incremented by a margin to avoid port clashing.
-func incrementDefaultServerPortsBy(s Servers, inc uint64) (Servers, error) {
-	var err error
-
-	if s.GRPC.Address == DefaultGRPCAddress {
-		s.GRPC.Address, err = xnet.IncreasePortBy(DefaultGRPCAddress, inc)
-		if err != nil {
-			return Servers{}, err
-		}
-	}
-
-	if s.GRPCWeb.Address == DefaultGRPCWebAddress {
-		s.GRPCWeb.Address, err = xnet.IncreasePortBy(DefaultGRPCWebAddress, inc)
-		if err != nil {
-			return Servers{}, err
-		}
-	}
-
-	if s.API.Address == DefaultAPIAddress {
-		s.API.Address, err = xnet.IncreasePortBy(DefaultAPIAddress, inc)
-		if err != nil {
-			return Servers{}, err
-		}
-	}
-
-	if s.P2P.Address == DefaultP2PAddress {
-		s.P2P.Address, err = xnet.IncreasePortBy(DefaultP2PAddress, inc)
-		if err != nil {
-			return Servers{}, err
-		}
-	}
-
-	if s.RPC.Address == DefaultRPCAddress {
-		s.RPC.Address, err = xnet.IncreasePortBy(DefaultRPCAddress, inc)
-		if err != nil {
-			return Servers{}, err
-		}
-	}
-
-	if s.RPC.PProfAddress == DefaultPProfAddress {
-		s.RPC.PProfAddress, err = xnet.IncreasePortBy(DefaultPProfAddress, inc)
-		if err != nil {
-			return Servers{}, err
-		}
-	}
-
-	return s, nil
-}
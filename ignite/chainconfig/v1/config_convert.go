@@ -1,9 +1,20 @@
 package v1
 
-import "github.com/ignite/cli/ignite/chainconfig/config"
+import (
+	"github.com/ignite/cli/ignite/chainconfig/config"
+	v2 "github.com/ignite/cli/ignite/chainconfig/v2"
+)
 
-// ConvertNext implements the conversion of the current config to the next version.
+// ConvertNext converts the current config version to the next one.
 func (c *Config) ConvertNext() (config.Converter, error) {
-	// v1 is the latest version, there is no need to convert.
-	return c, nil
+	targetCfg := v2.DefaultConfig()
+
+	// All the fields in the base config remain the same
+	targetCfg.BaseConfig = c.BaseConfig
+	targetCfg.Version = 2
+
+	// Validators didn't change in version 2
+	targetCfg.Validators = c.Validators
+
+	return targetCfg, nil
 }
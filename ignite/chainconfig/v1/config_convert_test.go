@@ -0,0 +1,32 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig/config"
+	v1testdata "github.com/ignite/cli/ignite/chainconfig/v1/testdata"
+	v2 "github.com/ignite/cli/ignite/chainconfig/v2"
+)
+
+func TestV1ToV2(t *testing.T) {
+	// Arrange
+	cfgV1 := v1testdata.GetConfig(t)
+
+	// Act
+	c, err := cfgV1.ConvertNext()
+	cfgV2, _ := c.(*v2.Config)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNilf(t, cfgV2, "expected *v2.Config, got %T", c)
+	require.Equal(t, config.Version(2), cfgV2.GetVersion())
+	require.Equal(t, cfgV1.Build, cfgV2.Build)
+	require.Equal(t, cfgV1.Accounts, cfgV2.Accounts)
+	require.Equal(t, cfgV1.Faucet, cfgV2.Faucet)
+	require.Equal(t, cfgV1.Client, cfgV2.Client)
+	require.Equal(t, cfgV1.Genesis, cfgV2.Genesis)
+	require.Equal(t, cfgV1.Validators, cfgV2.Validators)
+	require.Empty(t, cfgV2.Hooks)
+}
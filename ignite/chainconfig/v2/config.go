@@ -0,0 +1,148 @@
+package v2
+
+import (
+	"io"
+
+	"github.com/imdario/mergo"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/chainconfig/config"
+)
+
+// DefaultConfig returns a config with default values.
+func DefaultConfig() *Config {
+	c := Config{BaseConfig: config.DefaultBaseConfig()}
+	c.Version = 2
+	return &c
+}
+
+// Config is the user given configuration to do additional setup during serve.
+type Config struct {
+	config.BaseConfig `yaml:",inline"`
+
+	Validators []Validator `yaml:"validators"`
+
+	// Control configures the local control API of the serve command.
+	Control Control `yaml:"control,omitempty"`
+
+	// Hooks defines the shell commands to run during the chain lifecycle.
+	Hooks Hooks `yaml:"hooks,omitempty"`
+
+	// Fixtures is the path to a JSON file with transactions that are
+	// broadcasted each time the chain state is initialized by serve.
+	Fixtures string `yaml:"fixtures,omitempty"`
+
+	// DevPreset is the name of the preset that adapts the genesis and the consensus
+	// timing of the chain for local development: fast, default or realistic.
+	DevPreset string `yaml:"dev_preset,omitempty"`
+
+	// Tokens defines the tokens of the chain with the metadata used to display their amounts.
+	Tokens []Token `yaml:"tokens,omitempty"`
+}
+
+// Token defines a token of the chain.
+// The token is written as denom metadata in the genesis of the bank module.
+type Token struct {
+	// Denom is the base denom of the token, used in the amounts of the coins, e.g. uatom.
+	Denom string `yaml:"denom"`
+
+	// Display is the denom used to display the amounts of the token, e.g. atom.
+	Display string `yaml:"display,omitempty"`
+
+	// Exponent is the power of 10 of the display denom to the base denom, e.g. 6.
+	Exponent uint32 `yaml:"exponent,omitempty"`
+
+	// Units are other units of the token, e.g. matom with exponent 3.
+	Units []TokenUnit `yaml:"units,omitempty"`
+
+	// Symbol is the symbol of the token, e.g. ATOM.
+	Symbol string `yaml:"symbol,omitempty"`
+
+	// Name is the name of the token, e.g. Cosmos Hub Atom.
+	Name string `yaml:"name,omitempty"`
+
+	// Description describes the token.
+	Description string `yaml:"description,omitempty"`
+
+	// URI is the URI of a document with more information about the token.
+	URI string `yaml:"uri,omitempty"`
+}
+
+// TokenUnit defines a unit of a token.
+type TokenUnit struct {
+	// Denom is the denom of the unit.
+	Denom string `yaml:"denom"`
+
+	// Exponent is the power of 10 of the unit to the base denom of the token.
+	Exponent uint32 `yaml:"exponent"`
+
+	// Aliases are other names of the unit.
+	Aliases []string `yaml:"aliases,omitempty"`
+}
+
+// Validator holds info related to validator settings.
+// The validator settings didn't change since version 1.
+type Validator = config.Validator
+
+// Servers contains information about the validator server addresses.
+type Servers = config.Servers
+
+// Control holds the configuration of the local control API that allows
+// other tools to manage a running serve session.
+type Control struct {
+	// Port number for the control API to listen at in the local host.
+	// The control API is disabled when no port is specified.
+	Port int `yaml:"port,omitempty"`
+}
+
+// Hooks holds shell commands that are executed at defined points of the
+// chain lifecycle. The commands of each hook run in order within the app's
+// directory and a failing command stops the execution of the hook.
+type Hooks struct {
+	// PreBuild commands run before the chain binary is built.
+	PreBuild []string `yaml:"pre_build,omitempty"`
+
+	// PostBuild commands run after the chain binary is built.
+	PostBuild []string `yaml:"post_build,omitempty"`
+
+	// PostInit commands run after the chain is initialized and before the nodes start.
+	PostInit []string `yaml:"post_init,omitempty"`
+
+	// PostStart commands run once the RPC of the chain nodes is healthy.
+	PostStart []string `yaml:"post_start,omitempty"`
+
+	// PreExport commands run before the chain state is exported.
+	PreExport []string `yaml:"pre_export,omitempty"`
+}
+
+func (c *Config) SetDefaults() error {
+	if err := c.BaseConfig.SetDefaults(); err != nil {
+		return err
+	}
+
+	// Make sure that validator addresses don't chash with each other
+	if err := config.UpdateValidatorAddresses(c.Validators); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Clone returns an identical copy of the instance
+func (c *Config) Clone() (config.Converter, error) {
+	copy := Config{}
+	if err := mergo.Merge(&copy, c, mergo.WithAppendSlice); err != nil {
+		return nil, err
+	}
+
+	return &copy, nil
+}
+
+// Decode decodes the config file values from YAML.
+func (c *Config) Decode(r io.Reader) error {
+	if err := yaml.NewDecoder(r).Decode(c); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package testdata
+
+import (
+	"bytes"
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	v2 "github.com/ignite/cli/ignite/chainconfig/v2"
+)
+
+//go:embed config.yaml
+var ConfigYAML []byte
+
+func GetConfig(t *testing.T) *v2.Config {
+	c := &v2.Config{}
+
+	err := yaml.NewDecoder(bytes.NewReader(ConfigYAML)).Decode(c)
+	require.NoError(t, err)
+
+	err = c.SetDefaults()
+	require.NoError(t, err)
+
+	return c
+}
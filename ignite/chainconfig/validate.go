@@ -0,0 +1,216 @@
+package chainconfig
+
+import (
+	"fmt"
+)
+
+// ValidateFiles validates a config defined in multiple layered files.
+// All the problems found in the config are returned with the file and
+// the position that defines each invalid value.
+// An error is returned when the config files can't be read or decoded.
+func ValidateFiles(paths ...string) ([]*ValidationError, error) {
+	layers, err := readLayers(paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	interpolateLayers(layers)
+
+	cfg, err := decodeLayers(layers...)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := validationErrors(cfg, true)
+	for _, err := range errs {
+		locateValidationError(err, layers)
+	}
+
+	return errs, nil
+}
+
+// validationErrors returns all the problems found in a config.
+// The strict rules are only checked when strict is true, they report problems
+// like duplicated account names that the configs used by serve are allowed to have.
+func validationErrors(c *Config, strict bool) (errs []*ValidationError) {
+	invalid := func(path, format string, args ...interface{}) {
+		errs = append(errs, &ValidationError{Message: fmt.Sprintf(format, args...), Path: path})
+	}
+
+	if len(c.Accounts) == 0 {
+		invalid("accounts", "at least one account is required")
+	}
+
+	// keys are the names of the accounts created in the keyring
+	keys := make(map[string]bool)
+
+	for i, account := range c.Accounts {
+		path := fmt.Sprintf("accounts[%d].name", i)
+
+		switch {
+		case !strict:
+		case account.Name == "":
+			invalid(path, "account 'name' is required")
+		case keys[account.Name]:
+			invalid(path, "account %q is defined more than once", account.Name)
+		}
+
+		keys[account.Name] = true
+	}
+
+	if len(c.Validators) == 0 {
+		invalid("validators", "at least one validator is required")
+	}
+
+	for i, validator := range c.Validators {
+		if validator.Name == "" {
+			invalid(fmt.Sprintf("validators[%d].name", i), "validator 'name' is required")
+		}
+
+		if validator.Bonded == "" {
+			invalid(fmt.Sprintf("validators[%d].bonded", i), "validator 'bonded' is required")
+		}
+
+		keys[validator.Name] = true
+	}
+
+	if strict && c.Faucet.Name != nil && !keys[*c.Faucet.Name] {
+		invalid("faucet.name", "faucet account %q is not defined in 'accounts'", *c.Faucet.Name)
+	}
+
+	if _, err := ConfigDevPreset(c); err != nil {
+		invalid("dev_preset", "%s", err)
+	}
+
+	errs = append(errs, tokenValidationErrors(c)...)
+
+	return errs
+}
+
+// tokenValidationErrors returns the problems found in the tokens of a config and,
+// when tokens are defined, in the denoms of the accounts, the faucet and the validators.
+func tokenValidationErrors(c *Config) (errs []*ValidationError) {
+	invalid := func(path, format string, args ...interface{}) {
+		errs = append(errs, &ValidationError{Message: fmt.Sprintf(format, args...), Path: path})
+	}
+
+	// denoms are the base denoms of the tokens
+	denoms := make(map[string]bool)
+
+	for i, t := range c.Tokens {
+		path := fmt.Sprintf("tokens[%d]", i)
+
+		switch {
+		case t.Denom == "":
+			invalid(path+".denom", "token 'denom' is required")
+		case !denomRegexp.MatchString(t.Denom):
+			invalid(path+".denom", "token denom %q is not valid", t.Denom)
+		case denoms[t.Denom]:
+			invalid(path+".denom", "token %q is defined more than once", t.Denom)
+		}
+
+		denoms[t.Denom] = true
+
+		// units are the denoms of the token with their exponent
+		units := map[string]uint32{t.Denom: 0}
+		exponents := map[uint32]bool{0: true}
+
+		for j, u := range t.Units {
+			unitPath := fmt.Sprintf("%s.units[%d]", path, j)
+
+			if _, ok := units[u.Denom]; ok {
+				invalid(unitPath+".denom", "unit %q is defined more than once in token %q", u.Denom, t.Denom)
+			} else if !denomRegexp.MatchString(u.Denom) {
+				invalid(unitPath+".denom", "unit denom %q is not valid", u.Denom)
+			}
+
+			switch {
+			case u.Exponent == 0:
+				invalid(unitPath+".exponent", "unit %q must have an exponent greater than 0", u.Denom)
+			case exponents[u.Exponent]:
+				invalid(unitPath+".exponent", "exponent %d is used by more than one unit of token %q", u.Exponent, t.Denom)
+			}
+
+			units[u.Denom] = u.Exponent
+			exponents[u.Exponent] = true
+		}
+
+		if t.Display == "" || t.Display == t.Denom {
+			if t.Exponent != 0 {
+				invalid(path+".display", "token 'display' is required when the exponent is greater than 0")
+			}
+
+			continue
+		}
+
+		if exponent, ok := units[t.Display]; ok {
+			if t.Exponent != 0 && t.Exponent != exponent {
+				invalid(path+".exponent", "token exponent %d doesn't match the exponent %d of the unit %q", t.Exponent, exponent, t.Display)
+			}
+		} else {
+			switch {
+			case !denomRegexp.MatchString(t.Display):
+				invalid(path+".display", "token display denom %q is not valid", t.Display)
+			case t.Exponent == 0:
+				invalid(path+".exponent", "token 'exponent' must be greater than 0 when the display denom is not the base denom")
+			case exponents[t.Exponent]:
+				invalid(path+".exponent", "exponent %d is used by more than one unit of token %q", t.Exponent, t.Denom)
+			}
+		}
+	}
+
+	// the denoms of the coins are only checked when the tokens are defined
+	if len(c.Tokens) == 0 {
+		return errs
+	}
+
+	checkCoin := func(path, coin string) {
+		if denom, ok := coinDenom(coin); ok && !denoms[denom] {
+			invalid(path, "denom %q is not defined in 'tokens'", denom)
+		}
+	}
+
+	for i, account := range c.Accounts {
+		for j, coin := range account.Coins {
+			checkCoin(fmt.Sprintf("accounts[%d].coins[%d]", i, j), coin)
+		}
+	}
+
+	for i, coin := range c.Faucet.Coins {
+		checkCoin(fmt.Sprintf("faucet.coins[%d]", i), coin)
+	}
+
+	for i, coin := range c.Faucet.CoinsMax {
+		checkCoin(fmt.Sprintf("faucet.coins_max[%d]", i), coin)
+	}
+
+	for i, validator := range c.Validators {
+		if validator.Bonded != "" {
+			checkCoin(fmt.Sprintf("validators[%d].bonded", i), validator.Bonded)
+		}
+	}
+
+	return errs
+}
+
+// locateValidationError assigns the file and the position that define the invalid value.
+// Lists are replaced as a whole by the layers, so the value of a list element is defined by
+// the last layer that defines the list. Otherwise the value is defined by the last layer
+// that defines the whole path, or the closest parent of the value when none does.
+func locateValidationError(e *ValidationError, layers []configLayer) {
+	e.Layer = layers[0].name
+
+	var closest bool
+	for i := len(layers) - 1; i >= 0; i-- {
+		m := matchYAMLPath(layers[i].data, e.Path)
+		if m.complete || m.inList {
+			e.Layer, e.Line, e.Column = layers[i].name, m.line, m.column
+			return
+		}
+
+		if m.line > 0 && !closest {
+			e.Layer, e.Line, e.Column = layers[i].name, m.line, m.column
+			closest = true
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package chainconfig_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestValidateFiles(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yml")
+	profilePath := chainconfig.ProfilePath(configPath, "devnet")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: 2
+accounts:
+- name: alice
+  coins: ["100token"]
+- name: alice
+  coins: ["100token"]
+validators:
+- name: alice
+  bonded: 100token
+faucet:
+  name: bob
+`), 0o644))
+	require.NoError(t, os.WriteFile(profilePath, []byte(`validators:
+- name: alice
+- bonded: 100token
+faucet:
+  coins: ["5token"]
+`), 0o644))
+
+	want := []struct {
+		message, position string
+	}{
+		{`account "alice" is defined more than once`, configPath + ":5:3"},
+		{"validator 'bonded' is required", profilePath + ":2:3"},
+		{"validator 'name' is required", profilePath + ":3:3"},
+		{`faucet account "bob" is not defined in 'accounts'`, configPath + ":11:3"},
+	}
+
+	// Act
+	errs, err := chainconfig.ValidateFiles(configPath, profilePath)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, errs, len(want))
+
+	for i, w := range want {
+		require.Equal(t, w.message, errs[i].Message)
+		require.Equal(t, w.position, errs[i].Position())
+	}
+}
+
+func TestValidateFilesWithoutAccounts(t *testing.T) {
+	// Arrange
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: 2
+validators:
+- name: alice
+  bonded: 100token
+`), 0o644))
+
+	// Act
+	errs, err := chainconfig.ValidateFiles(configPath)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, "at least one account is required", errs[0].Message)
+	require.Equal(t, configPath, errs[0].Position())
+}
+
+func TestValidateFilesWithInvalidDevPreset(t *testing.T) {
+	// Arrange
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: 2
+accounts:
+- name: alice
+  coins: ["100token"]
+validators:
+- name: alice
+  bonded: 100token
+dev_preset: instant
+`), 0o644))
+
+	// Act
+	errs, err := chainconfig.ValidateFiles(configPath)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, `dev preset "instant" doesn't exist, use one of [default fast realistic]`, errs[0].Message)
+	require.Equal(t, configPath+":8:1", errs[0].Position())
+}
+
+func TestValidateFilesWithUndefinedTokenDenom(t *testing.T) {
+	// Arrange
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: 2
+accounts:
+- name: alice
+  coins:
+  - 100token
+  - 5stake
+validators:
+- name: alice
+  bonded: 100stake
+tokens:
+- denom: stake
+  display: mystake
+  exponent: 6
+`), 0o644))
+
+	// Act
+	errs, err := chainconfig.ValidateFiles(configPath)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, `denom "token" is not defined in 'tokens'`, errs[0].Message)
+	require.Equal(t, configPath+":5:5", errs[0].Position())
+}
+
+func TestParseFilesSkipsStrictRules(t *testing.T) {
+	// Arrange
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: 2
+accounts:
+- name: alice
+  coins: ["100token"]
+- name: alice
+  coins: ["100token"]
+validators:
+- name: alice
+  bonded: 100token
+faucet:
+  name: bob
+`), 0o644))
+
+	// Act
+	_, err := chainconfig.ParseFiles(configPath)
+
+	// Assert
+	require.NoError(t, err)
+}
@@ -0,0 +1,141 @@
+package chainconfig
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlMatch is the deepest node of a YAML document that matches a config path.
+type yamlMatch struct {
+	// line and column are the position of the node, zero when no node matches.
+	line, column int
+
+	// complete is true when the whole path matches.
+	complete bool
+
+	// inList is true when the matching nodes include a list element.
+	inList bool
+}
+
+// matchYAMLPath finds the deepest node of a YAML document that matches a config path.
+// The path elements are separated by dots and list indexes use brackets, e.g. "validators[0].name".
+func matchYAMLPath(data []byte, path string) (m yamlMatch) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil || len(doc.Content) == 0 {
+		return m
+	}
+
+	node := doc.Content[0]
+	for _, elem := range splitConfigPath(path) {
+		var next, pos *yaml.Node
+
+		if index, err := strconv.Atoi(elem); err == nil {
+			if node.Kind == yaml.SequenceNode && index < len(node.Content) {
+				next, pos = node.Content[index], node.Content[index]
+				m.inList = true
+			}
+		} else if node.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == elem {
+					next, pos = node.Content[i+1], node.Content[i]
+					break
+				}
+			}
+		}
+
+		if next == nil {
+			return m
+		}
+
+		node = next
+		m.line, m.column = pos.Line, pos.Column
+	}
+
+	m.complete = true
+
+	return m
+}
+
+// splitConfigPath splits a config path into keys and list indexes.
+func splitConfigPath(path string) []string {
+	path = strings.ReplaceAll(path, "[", ".")
+	path = strings.ReplaceAll(path, "]", "")
+	return strings.Split(path, ".")
+}
+
+// copyYAMLComments copies the comments of a YAML document to the nodes of
+// another document that have the same path and returns the updated document.
+// The document is returned unchanged when the source document has no comments.
+func copyYAMLComments(src, dst []byte) ([]byte, error) {
+	var srcDoc, dstDoc yaml.Node
+	if err := yaml.Unmarshal(src, &srcDoc); err != nil {
+		return nil, err
+	}
+
+	if !hasYAMLComments(&srcDoc) {
+		return dst, nil
+	}
+
+	if err := yaml.Unmarshal(dst, &dstDoc); err != nil {
+		return nil, err
+	}
+
+	copyNodeComments(&srcDoc, &dstDoc)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&dstDoc); err != nil {
+		return nil, err
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func hasYAMLComments(node *yaml.Node) bool {
+	if node.HeadComment != "" || node.LineComment != "" || node.FootComment != "" {
+		return true
+	}
+
+	for _, n := range node.Content {
+		if hasYAMLComments(n) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func copyNodeComments(src, dst *yaml.Node) {
+	dst.HeadComment = src.HeadComment
+	dst.LineComment = src.LineComment
+	dst.FootComment = src.FootComment
+
+	switch {
+	case src.Kind != dst.Kind:
+		return
+	case src.Kind == yaml.MappingNode:
+		// Copy the comments of the keys that exist in both mappings
+		for i := 0; i+1 < len(dst.Content); i += 2 {
+			for j := 0; j+1 < len(src.Content); j += 2 {
+				if dst.Content[i].Value == src.Content[j].Value {
+					copyNodeComments(src.Content[j], dst.Content[i])
+					copyNodeComments(src.Content[j+1], dst.Content[i+1])
+					break
+				}
+			}
+		}
+	default:
+		// Copy the comments of the document and list elements with the same index
+		for i := 0; i < len(src.Content) && i < len(dst.Content); i++ {
+			copyNodeComments(src.Content[i], dst.Content[i])
+		}
+	}
+}
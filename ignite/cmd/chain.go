@@ -74,6 +74,18 @@ to send token from any other account that exists on chain.
 
 The "simulate" command helps you start a simulation testing process for your
 chain.
+
+The "snapshot" command lets you save named snapshots of the chain state and
+restore them later, for example to start serving the chain from a known state.
+
+The "genesis" command lets you read, edit, compare and validate the genesis file
+of the chain or any other genesis file, like the exported state of a network.
+
+The "replay" command broadcasts again the transactions that were delivered by the
+chain before its state was reset by the "serve" command.
+
+The "config" command lets you validate the config file of the chain, migrate it
+to the latest version, print the effective config and export its JSON Schema.
 `,
 		Aliases:           []string{"c"},
 		Args:              cobra.ExactArgs(1),
@@ -89,6 +101,10 @@ chain.
 	c.AddCommand(NewChainInit())
 	c.AddCommand(NewChainFaucet())
 	c.AddCommand(NewChainSimulate())
+	c.AddCommand(NewChainSnapshot())
+	c.AddCommand(NewChainGenesis())
+	c.AddCommand(NewChainReplay())
+	c.AddCommand(NewChainConfig())
 
 	return c
 }
@@ -136,15 +152,14 @@ func configMigrationPreRunHandler(cmd *cobra.Command, args []string) (err error)
 			session.Printf("%s %s\n", icons.Info, colors.Infof(msgMigration, version, chainconfig.LatestVersion))
 		}
 
-		file, err := os.OpenFile(configPath, os.O_WRONLY|os.O_TRUNC, 0o755)
-		if err != nil {
+		// Convert the current config to the latest version before updating
+		// the YAML file to keep the file unchanged when the config is not valid
+		var latest bytes.Buffer
+		if err := chainconfig.MigrateLatest(bytes.NewReader(rawCfg), &latest); err != nil {
 			return err
 		}
 
-		defer file.Close()
-
-		// Convert the current config to the latest version and update the YAML file
-		return chainconfig.MigrateLatest(bytes.NewReader(rawCfg), file)
+		return os.WriteFile(configPath, latest.Bytes(), 0o755)
 	}
 
 	return nil
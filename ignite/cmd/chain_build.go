@@ -2,6 +2,7 @@ package ignitecmd
 
 import (
 	"fmt"
+	"io"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
@@ -15,9 +16,12 @@ import (
 const (
 	flagCheckDependencies = "check-dependencies"
 	flagOutput            = "output"
+	flagOutputDir         = "output-dir"
 	flagRelease           = "release"
 	flagReleasePrefix     = "release.prefix"
 	flagReleaseTargets    = "release.targets"
+	flagReleaseOCI        = "release.oci"
+	flagJobs              = "jobs"
 )
 
 // NewChainBuild returns a new build command to build a blockchain app.
@@ -31,7 +35,7 @@ installs the binary in the $(go env GOPATH)/bin directory.
 
 You can customize the output directory for the binary using a flag:
 
-  ignite chain build --output dist
+  ignite chain build --output-dir dist
 
 To compile the binary Ignite first compiles protocol buffer (proto) files into
 Go source code. Proto files contain required type and services definitions. If
@@ -76,6 +80,44 @@ tags. If the optional --release.targets is not specified, a binary is created
 for your current environment.
 
   ignite chain build --release -t linux:amd64 -t darwin:amd64 -t darwin:arm64
+
+Release builds are reproducible: the binaries are built without file system
+paths and VCS information, and the files of the tarballs use the commit time of
+the source, or the time of the SOURCE_DATE_EPOCH environment variable when it's
+set. Next to the tarballs, the release includes a "release_manifest.json" file
+that records the Go version, the modules required by go.mod, the build flags and
+the checksums of each target, and a "release_sbom.cdx.json" CycloneDX SBOM of the
+resolved Go modules, so the binaries can be verified independently.
+
+The release targets are built concurrently, by default using half of the CPUs.
+Use the --jobs flag to change the number of targets built at the same time. A
+failing target doesn't stop the build of the other targets and a summary of the
+builds is printed at the end.
+
+Chains can also be shipped as container images without a Dockerfile nor a
+Docker daemon. Use the --release.oci flag to create an OCI image for each linux
+release target:
+
+  ignite chain build --release --release.oci -t linux:amd64 -t linux:arm64
+
+Each image is saved as a "{prefix}_linux_{arch}.oci.tar" tarball of an OCI image
+layout, which contains the binary, a minimal root filesystem, the default ports
+of the node and an entrypoint that starts the node. The image can be pushed to
+a registry with any OCI compatible tool, for example:
+
+  skopeo copy oci-archive:release/mars_linux_amd64.oci.tar docker://example.com/mars:latest
+
+The images don't include a C library, so build static binaries by disabling cgo
+in config.yml:
+
+build:
+  cgo: false
+
+To integrate the build command with other tools, the text output can be
+replaced with a stream of JSON events, one per line, that describe the build
+with its status, timing and errors:
+
+  ignite chain build --output json
 `,
 		Args: cobra.NoArgs,
 		RunE: chainBuildHandler,
@@ -89,7 +131,10 @@ for your current environment.
 	c.Flags().Bool(flagRelease, false, "build for a release")
 	c.Flags().StringSliceP(flagReleaseTargets, "t", []string{}, "release targets. Available only with --release flag")
 	c.Flags().String(flagReleasePrefix, "", "tarball prefix for each release target. Available only with --release flag")
-	c.Flags().StringP(flagOutput, "o", "", "binary output path")
+	c.Flags().Bool(flagReleaseOCI, false, "create an OCI image for each linux release target. Available only with --release flag")
+	c.Flags().IntP(flagJobs, "j", 0, "number of release targets built concurrently, half of the CPUs by default. Available only with --release flag")
+	c.Flags().String(flagOutputDir, "", "binary output path")
+	c.Flags().AddFlagSet(flagSetBuildOutputFormat())
 	c.Flags().BoolP("verbose", "v", false, "verbose output")
 
 	return c
@@ -100,7 +145,9 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		isRelease, _      = cmd.Flags().GetBool(flagRelease)
 		releaseTargets, _ = cmd.Flags().GetStringSlice(flagReleaseTargets)
 		releasePrefix, _  = cmd.Flags().GetString(flagReleasePrefix)
-		output, _         = cmd.Flags().GetString(flagOutput)
+		releaseOCI, _     = cmd.Flags().GetBool(flagReleaseOCI)
+		jobs, _           = cmd.Flags().GetInt(flagJobs)
+		output, _         = cmd.Flags().GetString(flagOutputDir)
 	)
 
 	chainOption := []chain.Option{
@@ -116,6 +163,33 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		chainOption = append(chainOption, chain.CheckDependencies())
 	}
 
+	outputJSON, err := flagGetOutputJSON(cmd, flagOutput)
+	if err != nil {
+		// the binary output path was set with the "--output" flag before it was renamed,
+		// the values that are not an output format are still used as the binary output path
+		if output != "" {
+			return err
+		}
+
+		output, _ = cmd.Flags().GetString(flagOutput)
+		fmt.Fprintf(
+			cmd.ErrOrStderr(),
+			"⚠️  Setting the binary output path with --%s is deprecated, use --%s instead\n",
+			flagOutput,
+			flagOutputDir,
+		)
+	}
+	if outputJSON {
+		out := newEventOutput(cmd.OutOrStdout())
+		defer out.Close()
+
+		// the text output of the command is discarded to keep the output machine-readable
+		cmd.SetOut(io.Discard)
+
+		// text logs are disabled to keep the output machine-readable
+		chainOption = append(chainOption, chain.LogLevel(chain.LogSilent), chain.CollectEvents(out.Bus()))
+	}
+
 	c, err := newChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
 		return err
@@ -127,12 +201,24 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 	}
 
 	if isRelease {
-		releasePath, err := c.BuildRelease(cmd.Context(), cacheStorage, output, releasePrefix, releaseTargets...)
+		releaseOptions := []chain.ReleaseOption{chain.ReleaseJobs(jobs)}
+		if releaseOCI {
+			releaseOptions = append(releaseOptions, chain.ReleaseOCI())
+		}
+
+		releasePath, err := c.BuildRelease(
+			cmd.Context(),
+			cacheStorage,
+			output,
+			releasePrefix,
+			releaseTargets,
+			releaseOptions...,
+		)
 		if err != nil {
 			return err
 		}
 
-		fmt.Printf("🗃  Release created: %s\n", colors.Info(releasePath))
+		fmt.Fprintf(cmd.OutOrStdout(), "🗃  Release created: %s\n", colors.Info(releasePath))
 
 		return nil
 	}
@@ -143,15 +229,23 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 	}
 
 	if output == "" {
-		fmt.Printf("🗃  Installed. Use with: %s\n", colors.Info(binaryName))
+		fmt.Fprintf(cmd.OutOrStdout(), "🗃  Installed. Use with: %s\n", colors.Info(binaryName))
 	} else {
 		binaryPath := filepath.Join(output, binaryName)
-		fmt.Printf("🗃  Binary built at the path: %s\n", colors.Info(binaryPath))
+		fmt.Fprintf(cmd.OutOrStdout(), "🗃  Binary built at the path: %s\n", colors.Info(binaryPath))
 	}
 
 	return nil
 }
 
+// flagSetBuildOutputFormat returns the output format flag of the build command
+// with the "-o" shorthand, which was used by the binary output path before.
+func flagSetBuildOutputFormat() *flag.FlagSet {
+	fs := flagSetOutputFormat(flagOutput)
+	fs.Lookup(flagOutput).Shorthand = "o"
+	return fs
+}
+
 func flagSetCheckDependencies() *flag.FlagSet {
 	usage := "verify that cached dependencies have not been modified since they were downloaded"
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
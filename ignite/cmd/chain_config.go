@@ -0,0 +1,60 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+// NewChainConfig creates a new config command that holds
+// sub commands to manage the config file of a blockchain.
+func NewChainConfig() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "config [command]",
+		Short: "Validate, migrate, show and export the schema of the chain config",
+		Long: `Commands in this namespace let you work with the config file of your blockchain.
+
+The "validate" command reports every problem of the config with its position in
+the config files. The "migrate" command rewrites a config file of an older
+version to the latest version, keeping its comments where possible. The "show"
+command prints the effective config, with the values of the profile and the
+default values. The "schema" command prints the JSON Schema of the config that
+editors can use to validate and autocomplete config files:
+
+  ignite chain config schema > config.schema.json
+`,
+		Aliases: []string{"cfg"},
+		Args:    cobra.ExactArgs(1),
+		// The config commands work with config files of any version,
+		// so the config file is not migrated before running them.
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+	}
+
+	flagSetPath(c)
+
+	c.AddCommand(NewChainConfigValidate())
+	c.AddCommand(NewChainConfigMigrate())
+	c.AddCommand(NewChainConfigShow())
+	c.AddCommand(NewChainConfigSchema())
+
+	return c
+}
+
+// chainConfigPaths returns the paths of the config files selected by the flags,
+// which are the config file and the file of the config profile when there is one.
+func chainConfigPaths(cmd *cobra.Command) ([]string, error) {
+	configPath := getConfig(cmd)
+	if configPath == "" {
+		var err error
+		if configPath, err = chainconfig.LocateDefault(flagGetPath(cmd)); err != nil {
+			return nil, err
+		}
+	}
+
+	paths := []string{configPath}
+	if profile := getProfile(cmd); profile != "" {
+		paths = append(paths, chainconfig.ProfilePath(configPath, profile))
+	}
+
+	return paths, nil
+}
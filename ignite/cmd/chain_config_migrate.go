@@ -0,0 +1,73 @@
+package ignitecmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainConfigMigrate creates a new command to migrate the config of the chain to the latest version.
+func NewChainConfigMigrate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the chain config file to the latest version",
+		Long: `Migrate the chain config file to the latest version.
+
+The config file is rewritten in place. The comments of the fields that exist
+in the latest version are kept and the environment variable references are
+not replaced by their values.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainConfigMigrateHandler,
+	}
+
+	return c
+}
+
+func chainConfigMigrateHandler(cmd *cobra.Command, _ []string) error {
+	paths, err := chainConfigPaths(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Only the config file defines the version, the profile uses the same version
+	configPath := paths[0]
+
+	info, err := os.Stat(configPath)
+	if err != nil {
+		return err
+	}
+
+	rawCfg, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+
+	version, err := chainconfig.ReadConfigVersion(bytes.NewReader(rawCfg))
+	if err != nil {
+		return err
+	}
+
+	if version == chainconfig.LatestVersion {
+		fmt.Printf("%s Config is already at the latest version v%d\n", icons.OK, version)
+		return nil
+	}
+
+	var latest bytes.Buffer
+	if err := chainconfig.MigrateLatest(bytes.NewReader(rawCfg), &latest); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(configPath, latest.Bytes(), info.Mode()); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Config migrated from v%d to v%d\n", icons.OK, version, chainconfig.LatestVersion)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+// NewChainConfigSchema creates a new command to print the JSON Schema of the chain config.
+func NewChainConfigSchema() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the chain config",
+		Long: `Print the JSON Schema of the latest version of the chain config.
+
+Editors can use the schema to validate and autocomplete config files, for
+example the YAML extension of Visual Studio Code uses the schema referenced
+by a comment at the top of the config file:
+
+  # yaml-language-server: $schema=config.schema.json
+`,
+		Args: cobra.NoArgs,
+		RunE: chainConfigSchemaHandler,
+	}
+
+	return c
+}
+
+func chainConfigSchemaHandler(*cobra.Command, []string) error {
+	schema, err := chainconfig.JSONSchema()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(schema))
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package ignitecmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+// NewChainConfigShow creates a new command to print the effective config of the chain.
+func NewChainConfigShow() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "show",
+		Short: "Print the effective chain config",
+		Long: `Print the effective chain config.
+
+The config is printed in YAML with the values of the config profile, the values
+of the environment variables and the default values of the fields that are not
+defined in the config files. The config is migrated to the latest version.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainConfigShowHandler,
+	}
+
+	return c
+}
+
+func chainConfigShowHandler(cmd *cobra.Command, _ []string) error {
+	paths, err := chainConfigPaths(cmd)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := chainconfig.ParseFiles(paths...)
+	if err != nil {
+		return err
+	}
+
+	return yaml.NewEncoder(os.Stdout).Encode(cfg)
+}
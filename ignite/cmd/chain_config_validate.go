@@ -0,0 +1,45 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainConfigValidate creates a new command to validate the config of the chain.
+func NewChainConfigValidate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validate",
+		Short: "Report the problems of the chain config",
+		Args:  cobra.NoArgs,
+		RunE:  chainConfigValidateHandler,
+	}
+
+	return c
+}
+
+func chainConfigValidateHandler(cmd *cobra.Command, _ []string) error {
+	paths, err := chainConfigPaths(cmd)
+	if err != nil {
+		return err
+	}
+
+	errs, err := chainconfig.ValidateFiles(paths...)
+	if err != nil {
+		return err
+	}
+
+	if len(errs) == 0 {
+		fmt.Printf("%s Config is valid\n", icons.OK)
+		return nil
+	}
+
+	for _, err := range errs {
+		fmt.Printf("%s %s: %s\n", icons.NotOK, err.Position(), err.Message)
+	}
+
+	return fmt.Errorf("config is not valid: %d problem(s) found", len(errs))
+}
@@ -0,0 +1,117 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// NewChainGenesis creates a new genesis command that holds
+// sub commands to read and edit the genesis file of a blockchain.
+func NewChainGenesis() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "genesis [command]",
+		Short: "Read, edit, compare and validate the genesis file of the chain",
+		Long: `Commands in this namespace let you read and edit the genesis file of your
+blockchain without decoding it completely, so they work with large genesis files
+like the exported state of a network.
+
+By default the commands work on the genesis of the chain's home, which is
+created by "ignite chain init". Use the "--genesis" flag to work on any other
+genesis file:
+
+  ignite chain genesis get app_state.staking.params --genesis exported.json
+
+Values are selected by their JSON path, where the elements of arrays are
+selected by their index:
+
+  ignite chain genesis set app_state.bank.balances[0].coins[0].amount '"1000"'
+
+The "add-account", "add-vesting-account" and "validate" commands use the
+chain's binary, so they must be run in the chain's directory.
+`,
+		Aliases: []string{"g"},
+		Args:    cobra.ExactArgs(1),
+		// The config file is not required to work on a genesis file selected with the flag.
+		PersistentPreRunE: chainGenesisPreRunHandler,
+	}
+
+	flagSetPath(c)
+	c.PersistentFlags().AddFlagSet(flagSetHome())
+	c.PersistentFlags().AddFlagSet(flagSetGenesis())
+
+	c.AddCommand(NewChainGenesisGet())
+	c.AddCommand(NewChainGenesisSet())
+	c.AddCommand(NewChainGenesisAddAccount())
+	c.AddCommand(NewChainGenesisAddVestingAccount())
+	c.AddCommand(NewChainGenesisAddDenomMetadata())
+	c.AddCommand(NewChainGenesisSetConsensusParams())
+	c.AddCommand(NewChainGenesisDiff())
+	c.AddCommand(NewChainGenesisValidate())
+
+	return c
+}
+
+func flagSetGenesis() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagGenesis, "", "path of the genesis file, the chain's genesis is used by default")
+	return fs
+}
+
+func flagGetGenesis(cmd *cobra.Command) (path string) {
+	path, _ = cmd.Flags().GetString(flagGenesis)
+	return
+}
+
+func chainGenesisPreRunHandler(cmd *cobra.Command, args []string) error {
+	// A genesis file selected with the flag can be edited outside of a chain's directory
+	if flagGetGenesis(cmd) != "" && getConfig(cmd) == "" {
+		if _, err := chainconfig.LocateDefault(flagGetPath(cmd)); err != nil {
+			return nil
+		}
+	}
+
+	return configMigrationPreRunHandler(cmd, args)
+}
+
+// newChainGenesisChain creates the chain used by the genesis commands.
+func newChainGenesisChain(cmd *cobra.Command) (*chain.Chain, error) {
+	chainOption := []chain.Option{
+		chain.LogLevel(logLevel(cmd)),
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+	}
+
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	return newChainWithHomeFlags(cmd, chainOption...)
+}
+
+// chainGenesisPath returns the path of the genesis file selected by the flags.
+func chainGenesisPath(cmd *cobra.Command) (string, error) {
+	if path := flagGetGenesis(cmd); path != "" {
+		return path, nil
+	}
+
+	c, err := newChainGenesisChain(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return c.GenesisPath()
+}
+
+// openChainGenesis opens the genesis file selected by the flags.
+func openChainGenesis(cmd *cobra.Command) (*genesis.Genesis, error) {
+	path, err := chainGenesisPath(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis.FromPath(path)
+}
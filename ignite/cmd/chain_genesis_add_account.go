@@ -0,0 +1,44 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+)
+
+// NewChainGenesisAddAccount creates a new command to add an account to the genesis.
+func NewChainGenesisAddAccount() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "add-account [address] [coins]",
+		Short:   "Add an account with coins to the genesis",
+		Example: "  ignite chain genesis add-account cosmos1... 1000token,100000000stake",
+		Args:    cobra.ExactArgs(2),
+		RunE:    chainGenesisAddAccountHandler,
+	}
+
+	return c
+}
+
+func chainGenesisAddAccountHandler(cmd *cobra.Command, args []string) error {
+	address, coins := args[0], args[1]
+
+	c, err := newChainGenesisChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	path, err := chainGenesisPath(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.AddGenesisAccount(cmd.Context(), path, address, coins); err != nil {
+		return err
+	}
+
+	fmt.Printf("👤 Account %s added to the genesis with %s\n", colors.Info(address), coins)
+
+	return nil
+}
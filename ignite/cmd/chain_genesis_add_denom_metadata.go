@@ -0,0 +1,114 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+)
+
+const (
+	flagDenomDisplay     = "display"
+	flagDenomSymbol      = "symbol"
+	flagDenomName        = "name"
+	flagDenomDescription = "description"
+	flagDenomUnit        = "unit"
+)
+
+// NewChainGenesisAddDenomMetadata creates a new command to add the metadata of a denom to the genesis.
+func NewChainGenesisAddDenomMetadata() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "add-denom-metadata [base-denom]",
+		Short: "Add the metadata of a denom to the bank genesis state",
+		Long: `Add the metadata of a denom to the bank genesis state.
+
+The units of the denom are defined with the "--unit" flag as the denom of the
+unit and its exponent to the base denom. The base denom is always a unit with
+exponent 0.
+`,
+		Example: "  ignite chain genesis add-denom-metadata umars --unit mars:6 --display mars --symbol MARS",
+		Args:    cobra.ExactArgs(1),
+		RunE:    chainGenesisAddDenomMetadataHandler,
+	}
+
+	c.Flags().String(flagDenomDisplay, "", "Denom used to display the amounts, the base denom is used by default")
+	c.Flags().String(flagDenomSymbol, "", "Symbol of the denom")
+	c.Flags().String(flagDenomName, "", "Name of the denom")
+	c.Flags().String(flagDenomDescription, "", "Description of the denom")
+	c.Flags().StringSlice(flagDenomUnit, nil, "Unit of the denom as denom:exponent")
+
+	return c
+}
+
+func chainGenesisAddDenomMetadataHandler(cmd *cobra.Command, args []string) error {
+	var (
+		base           = args[0]
+		display, _     = cmd.Flags().GetString(flagDenomDisplay)
+		symbol, _      = cmd.Flags().GetString(flagDenomSymbol)
+		name, _        = cmd.Flags().GetString(flagDenomName)
+		description, _ = cmd.Flags().GetString(flagDenomDescription)
+		units, _       = cmd.Flags().GetStringSlice(flagDenomUnit)
+	)
+
+	if display == "" {
+		display = base
+	}
+
+	metadata := genesis.DenomMetadata{
+		Description: description,
+		DenomUnits:  []genesis.DenomUnit{{Denom: base}},
+		Base:        base,
+		Display:     display,
+		Name:        name,
+		Symbol:      symbol,
+	}
+
+	for _, u := range units {
+		unit, err := parseDenomUnit(u)
+		if err != nil {
+			return err
+		}
+
+		if unit.Denom == base {
+			if unit.Exponent != 0 {
+				return fmt.Errorf("the exponent of the base denom %s must be 0", base)
+			}
+			continue
+		}
+
+		metadata.DenomUnits = append(metadata.DenomUnits, unit)
+	}
+
+	g, err := openChainGenesis(cmd)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	if err := g.AddDenomMetadata(metadata); err != nil {
+		return err
+	}
+
+	fmt.Printf("🪙 Metadata of denom %s added to the genesis\n", colors.Info(base))
+
+	return nil
+}
+
+// parseDenomUnit parses a denom unit defined as denom:exponent.
+func parseDenomUnit(value string) (genesis.DenomUnit, error) {
+	denom, exponent, ok := strings.Cut(value, ":")
+	if !ok || denom == "" {
+		return genesis.DenomUnit{}, fmt.Errorf("invalid denom unit %q: must be denom:exponent", value)
+	}
+
+	e, err := strconv.ParseUint(exponent, 10, 32)
+	if err != nil {
+		return genesis.DenomUnit{}, fmt.Errorf("invalid exponent of denom unit %q: %w", value, err)
+	}
+
+	return genesis.DenomUnit{Denom: denom, Exponent: uint32(e)}, nil
+}
@@ -0,0 +1,76 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+)
+
+// NewChainGenesisAddVestingAccount creates a new command to add a vesting account to the genesis.
+func NewChainGenesisAddVestingAccount() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "add-vesting-account [address] [coins] [vesting-coins] [end-time]",
+		Short: "Add a vesting account with coins to the genesis",
+		Long: `Add a vesting account with coins to the genesis.
+
+The vesting coins are part of the coins of the account and they are vested
+until the end time, which is a Unix timestamp or a RFC3339 date.
+`,
+		Example: "  ignite chain genesis add-vesting-account cosmos1... 1000token 500token 2023-01-01T00:00:00Z",
+		Args:    cobra.ExactArgs(4),
+		RunE:    chainGenesisAddVestingAccountHandler,
+	}
+
+	return c
+}
+
+func chainGenesisAddVestingAccountHandler(cmd *cobra.Command, args []string) error {
+	address, coins, vestingCoins := args[0], args[1], args[2]
+
+	endTime, err := parseVestingEndTime(args[3])
+	if err != nil {
+		return err
+	}
+
+	c, err := newChainGenesisChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	path, err := chainGenesisPath(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.AddGenesisVestingAccount(cmd.Context(), path, address, coins, vestingCoins, endTime); err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"👤 Vesting account %s added to the genesis with %s vesting %s until %s\n",
+		colors.Info(address),
+		coins,
+		vestingCoins,
+		time.Unix(endTime, 0).UTC().Format(time.RFC3339),
+	)
+
+	return nil
+}
+
+// parseVestingEndTime parses an end time that is either a Unix timestamp or a RFC3339 date.
+func parseVestingEndTime(value string) (int64, error) {
+	if endTime, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return endTime, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid end time %q: must be a Unix timestamp or a RFC3339 date", value)
+	}
+
+	return t.Unix(), nil
+}
@@ -0,0 +1,82 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/jsondiff"
+)
+
+var (
+	diffAddedPrefix   = color.New(color.FgGreen).SprintFunc()("+")
+	diffRemovedPrefix = color.New(color.FgRed).SprintFunc()("-")
+	diffChangedPrefix = color.New(color.FgYellow).SprintFunc()("~")
+)
+
+// NewChainGenesisDiff creates a new command to compare genesis files.
+func NewChainGenesisDiff() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "diff [genesis] [other-genesis]",
+		Short: "Print the differences between two genesis files",
+		Long: `Print the differences between two genesis files.
+
+With one file, the genesis selected by the flags is compared with the file.
+The values of objects are compared by key and the values of arrays by index.
+`,
+		Example: `  ignite chain genesis diff exported.json
+  ignite chain genesis diff genesis.json exported.json`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: chainGenesisDiffHandler,
+	}
+
+	return c
+}
+
+func chainGenesisDiffHandler(cmd *cobra.Command, args []string) error {
+	var a, b string
+	if len(args) == 2 {
+		a, b = args[0], args[1]
+	} else {
+		var err error
+		if a, err = chainGenesisPath(cmd); err != nil {
+			return err
+		}
+		b = args[0]
+	}
+
+	aData, err := os.ReadFile(a)
+	if err != nil {
+		return err
+	}
+
+	bData, err := os.ReadFile(b)
+	if err != nil {
+		return err
+	}
+
+	changes, err := jsondiff.Diff(aData, bData)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		fmt.Println("The genesis files are equal")
+		return nil
+	}
+
+	for _, c := range changes {
+		switch c.Kind {
+		case jsondiff.KindAdded:
+			fmt.Printf("%s %s: %s\n", diffAddedPrefix, c.Path, c.New)
+		case jsondiff.KindRemoved:
+			fmt.Printf("%s %s: %s\n", diffRemovedPrefix, c.Path, c.Old)
+		case jsondiff.KindChanged:
+			fmt.Printf("%s %s: %s -> %s\n", diffChangedPrefix, c.Path, c.Old, c.New)
+		}
+	}
+
+	return nil
+}
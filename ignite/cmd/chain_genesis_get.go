@@ -0,0 +1,45 @@
+package ignitecmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// NewChainGenesisGet creates a new command to print a value of the genesis.
+func NewChainGenesisGet() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "get [path]",
+		Short: "Print the value of a JSON path of the genesis",
+		Example: `  ignite chain genesis get chain_id
+  ignite chain genesis get app_state.bank.balances[0].coins`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainGenesisGetHandler,
+	}
+
+	return c
+}
+
+func chainGenesisGetHandler(cmd *cobra.Command, args []string) error {
+	g, err := openChainGenesis(cmd)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	value, err := g.RawField(args[0])
+	if err != nil {
+		return fmt.Errorf("%s: %w", args[0], err)
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, value, "", "  "); err != nil {
+		return err
+	}
+
+	fmt.Println(out.String())
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/jsonfile"
+)
+
+// NewChainGenesisSet creates a new command to set a value of the genesis.
+func NewChainGenesisSet() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "set [path] [value]",
+		Short: "Set the value of a JSON path of the genesis",
+		Long: `Set the value of a JSON path of the genesis.
+
+The value is parsed as JSON, so objects, arrays, numbers and booleans can be
+set. Values that are not valid JSON are set as strings. Missing objects of the
+path are created.
+`,
+		Example: `  ignite chain genesis set chain_id mars-1
+  ignite chain genesis set app_state.staking.params.unbonding_time 60s
+  ignite chain genesis set app_state.gov.voting_params '{"voting_period":"60s"}'`,
+		Args: cobra.ExactArgs(2),
+		RunE: chainGenesisSetHandler,
+	}
+
+	return c
+}
+
+func chainGenesisSetHandler(cmd *cobra.Command, args []string) error {
+	path, value := args[0], []byte(args[1])
+
+	// values that are not JSON are set as strings
+	if !json.Valid(value) {
+		var err error
+		if value, err = json.Marshal(args[1]); err != nil {
+			return err
+		}
+	}
+
+	g, err := openChainGenesis(cmd)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	if err := g.Update(jsonfile.WithKeyRawValue(path, value)); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	fmt.Printf("📝 Genesis value %s set to %s\n", colors.Info(path), value)
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+	"github.com/ignite/cli/ignite/pkg/jsonfile"
+)
+
+const (
+	flagBlockMaxBytes        = "block-max-bytes"
+	flagBlockMaxGas          = "block-max-gas"
+	flagEvidenceMaxAgeBlocks = "evidence-max-age-blocks"
+	flagEvidenceMaxAge       = "evidence-max-age"
+	flagEvidenceMaxBytes     = "evidence-max-bytes"
+	flagValidatorPubKeyTypes = "validator-pub-key-types"
+)
+
+// NewChainGenesisSetConsensusParams creates a new command to set the consensus params of the genesis.
+func NewChainGenesisSetConsensusParams() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "set-consensus-params",
+		Short: "Set the Tendermint consensus params of the genesis",
+		Long: `Set the Tendermint consensus params of the genesis.
+
+Only the params of the flags that are used are changed.
+`,
+		Example: "  ignite chain genesis set-consensus-params --block-max-gas 100000000 --evidence-max-age 48h",
+		Args:    cobra.NoArgs,
+		RunE:    chainGenesisSetConsensusParamsHandler,
+	}
+
+	c.Flags().Int64(flagBlockMaxBytes, 0, "Max size of a block in bytes")
+	c.Flags().Int64(flagBlockMaxGas, 0, "Max gas of a block, -1 for no limit")
+	c.Flags().Int64(flagEvidenceMaxAgeBlocks, 0, "Max age of an evidence in blocks")
+	c.Flags().Duration(flagEvidenceMaxAge, 0, "Max age of an evidence")
+	c.Flags().Int64(flagEvidenceMaxBytes, 0, "Max size of the evidences of a block in bytes")
+	c.Flags().StringSlice(flagValidatorPubKeyTypes, nil, "Public key types of the validators")
+
+	return c
+}
+
+func chainGenesisSetConsensusParamsHandler(cmd *cobra.Command, _ []string) error {
+	var options []jsonfile.UpdateFileOption
+
+	// the params are int64 values encoded as strings
+	for flag, field := range map[string]string{
+		flagBlockMaxBytes:        genesis.FieldConsensusBlockMaxBytes,
+		flagBlockMaxGas:          genesis.FieldConsensusBlockMaxGas,
+		flagEvidenceMaxAgeBlocks: genesis.FieldConsensusEvidenceMaxAgeBlocks,
+		flagEvidenceMaxBytes:     genesis.FieldConsensusEvidenceMaxBytes,
+	} {
+		if !cmd.Flags().Changed(flag) {
+			continue
+		}
+
+		value, err := cmd.Flags().GetInt64(flag)
+		if err != nil {
+			return err
+		}
+
+		options = append(options, jsonfile.WithKeyValue(field, strconv.FormatInt(value, 10)))
+	}
+
+	if cmd.Flags().Changed(flagEvidenceMaxAge) {
+		maxAge, err := cmd.Flags().GetDuration(flagEvidenceMaxAge)
+		if err != nil {
+			return err
+		}
+
+		options = append(options, jsonfile.WithKeyValue(
+			genesis.FieldConsensusEvidenceMaxAge,
+			strconv.FormatInt(maxAge.Nanoseconds(), 10),
+		))
+	}
+
+	if cmd.Flags().Changed(flagValidatorPubKeyTypes) {
+		pubKeyTypes, err := cmd.Flags().GetStringSlice(flagValidatorPubKeyTypes)
+		if err != nil {
+			return err
+		}
+
+		value, err := json.Marshal(pubKeyTypes)
+		if err != nil {
+			return err
+		}
+
+		options = append(options, jsonfile.WithKeyRawValue(genesis.FieldConsensusValidatorPubKeyTypes, value))
+	}
+
+	if len(options) == 0 {
+		return errors.New("no consensus params to set, use the flags to set them")
+	}
+
+	g, err := openChainGenesis(cmd)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	if err := g.Update(options...); err != nil {
+		return err
+	}
+
+	fmt.Println("⚙️  Consensus params of the genesis updated")
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainGenesisValidate creates a new command to validate the genesis.
+func NewChainGenesisValidate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the genesis with the chain's binary",
+		Long: `Validate the genesis with the validate-genesis command of the chain's binary.
+
+When the genesis is not valid, the JSON paths of the values that caused the
+error are printed with it when they can be found.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainGenesisValidateHandler,
+	}
+
+	return c
+}
+
+func chainGenesisValidateHandler(cmd *cobra.Command, _ []string) error {
+	c, err := newChainGenesisChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	path, err := chainGenesisPath(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.ValidateGenesis(cmd.Context(), path); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Genesis is valid\n", icons.OK)
+
+	return nil
+}
@@ -4,12 +4,18 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/ignite/cli/ignite/pkg/chaincmd"
 	"github.com/ignite/cli/ignite/pkg/cliui/colors"
 	"github.com/ignite/cli/ignite/services/chain"
 )
 
+const (
+	flagFromExport   = "from-export"
+	flagFundAccounts = "fund-accounts"
+)
+
 func NewChainInit() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "init",
@@ -53,7 +59,12 @@ token balances. By default, config.yml has two accounts in the top-level
 Refer to config.yml guide to see which values you can set.
 
 One of these accounts is a validator account and the amount of self-delegated
-tokens can be set in the top-level "validator" property.
+tokens can be set in the top-level "validators" property. When more than one
+validator is defined, a data directory is initialized for each one of them.
+By default the data directory of each extra validator is the chain's data
+directory followed by the validator name, for example $HOME/.mychain-bob.
+All validators are included in the genesis and their nodes are configured as
+persistent peers of each other.
 
 One of the most important components of an initialized chain is the genesis
 file, the 0th block of the chain. The genesis file is stored in the data
@@ -70,6 +81,21 @@ genesis:
 The example above changes the staking token to "foo". If you change the staking
 denom, make sure the validator account has the right tokens.
 
+To test upgrades and fixes with the state of a real network, such as a mainnet,
+initialize the chain from a genesis exported from that network with "appd
+export":
+
+  ignite chain init --from-export mainnet_export.json
+
+The state of the network is forked into a local chain with the validator from
+config.yml. The staking, slashing and distribution state and the Tendermint
+validator set are rewritten so the local validator holds all the voting power,
+the validators of the network are jailed and the chain ID is replaced with the
+chain ID of the local chain. A single validator must be defined in config.yml
+and its bonded amount is minted in the staking denom of the network. To also
+add the accounts from config.yml with their token balances to the forked state,
+use the --fund-accounts flag.
+
 The init command is meant to be used ONLY FOR DEVELOPMENT PURPOSES. Under the
 hood it runs commands like "appd init", "appd add-genesis-account", "appd
 gentx", and "appd collect-gentx". For production, you may want to run these
@@ -84,6 +110,7 @@ commands manually to ensure a production-level node initialization.
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetCheckDependencies())
 	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetFork())
 
 	return c
 }
@@ -112,7 +139,11 @@ func chainInitHandler(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	if err := c.Init(cmd.Context(), true); err != nil {
+	if fromExport, forkOptions := flagGetFork(cmd); fromExport != "" {
+		if err := c.Fork(cmd.Context(), fromExport, forkOptions...); err != nil {
+			return err
+		}
+	} else if err := c.Init(cmd.Context(), true); err != nil {
 		return err
 	}
 
@@ -125,3 +156,19 @@ func chainInitHandler(cmd *cobra.Command, _ []string) error {
 
 	return nil
 }
+
+func flagSetFork() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagFromExport, "", "fork the state of a genesis exported from another network")
+	fs.Bool(flagFundAccounts, false, "add the accounts from the config to the forked state. Available only with --from-export flag")
+	return fs
+}
+
+func flagGetFork(cmd *cobra.Command) (fromExport string, options []chain.ForkOption) {
+	fromExport, _ = cmd.Flags().GetString(flagFromExport)
+	if fundAccounts, _ := cmd.Flags().GetBool(flagFundAccounts); fundAccounts {
+		options = append(options, chain.ForkFundAccounts())
+	}
+
+	return fromExport, options
+}
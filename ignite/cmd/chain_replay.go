@@ -0,0 +1,91 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// NewChainReplay creates a new replay command to broadcast again the
+// transactions recorded before the chain state was reset.
+func NewChainReplay() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "replay",
+		Short: "Broadcast again the transactions recorded before the chain state was reset",
+		Long: `While the chain is served, the transactions delivered by the chain are recorded.
+When the state of the chain is reset, for example because the config changed,
+the recorded transactions are kept so they can be broadcasted again to the new
+state with this command.
+
+The transactions are signed again by the accounts of the chain's keyring and they
+are broadcasted in the order they were delivered. The transactions that fail are
+reported and the replay continues with the next transaction.
+
+The chain must be served while the transactions are replayed.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainReplayHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagNode, "", "<host>:<port> to tendermint rpc interface of the chain (defaults to the first validator)")
+
+	return c
+}
+
+func chainReplayHandler(cmd *cobra.Command, _ []string) error {
+	chainOption := []chain.Option{
+		chain.LogLevel(logLevel(cmd)),
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+	}
+
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := newChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	var replayOptions []chain.ReplayOption
+	if node, _ := cmd.Flags().GetString(flagNode); node != "" {
+		replayOptions = append(replayOptions, chain.ReplayNodeAddress(node))
+	}
+
+	fmt.Println("📼 Replaying the recorded transactions...")
+
+	results, err := c.Replay(cmd.Context(), replayOptions...)
+	if errors.Is(err, chain.ErrNoRecordedTxs) {
+		fmt.Println("No recorded transactions found")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for i, r := range results {
+		if r.Err == nil {
+			continue
+		}
+
+		failed++
+		fmt.Printf(
+			"❌ Transaction #%d (%s) signed by %s failed: %s\n",
+			i+1,
+			r.Tx.Hash,
+			r.Tx.Signer,
+			r.Err,
+		)
+	}
+
+	fmt.Printf("✅ %d of %d transactions replayed.\n", len(results)-failed, len(results))
+
+	return nil
+}
@@ -1,15 +1,24 @@
 package ignitecmd
 
 import (
+	"io"
+
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
+	"github.com/ignite/cli/ignite/pkg/nodelog"
 	"github.com/ignite/cli/ignite/services/chain"
 )
 
 const (
-	flagForceReset = "force-reset"
-	flagResetOnce  = "reset-once"
-	flagConfig     = "config"
+	flagForceReset   = "force-reset"
+	flagResetOnce    = "reset-once"
+	flagConfig       = "config"
+	flagProfile      = "profile"
+	flagFromSnapshot = "from-snapshot"
+	flagLog          = "log"
+	flagLogMatch     = "log-match"
+	flagLogFile      = "log-file"
 )
 
 // NewChainServe creates a new serve command to serve a blockchain.
@@ -22,6 +31,13 @@ uses that binary to initialize the blockchain's data directory for one validator
 (like "ignite chain init"), and starts the node locally for development purposes
 with automatic code reloading.
 
+When more than one validator is defined in the config file, a node is started
+for each one of them. The nodes share the same genesis and are connected to each
+other as persistent peers.
+
+When the ports of the node servers or the faucet are in use by another program,
+the next free ports are used instead and the addresses are printed on start.
+
 Automatic code reloading means Ignite starts watching the project directory.
 Whenever a file change is detected, Ignite automatically rebuilds, reinitializes
 and restarts the node.
@@ -39,6 +55,17 @@ the following flag:
 
   ignite chain serve --force-reset
 
+To start from a state saved with "ignite chain snapshot save", use the following
+flag with the name of the snapshot:
+
+  ignite chain serve --from-snapshot before-migration
+
+To start from the state of another network, such as a mainnet, fork a genesis
+exported from that network. The validator from the config file holds all the
+voting power of the forked chain, see "ignite chain init --help":
+
+  ignite chain serve --from-export mainnet_export.json --fund-accounts
+
 With Ignite it's possible to start more than one blockchain from the same source
 code using different config files. This is handy if you're building
 inter-blockchain functionality and, for example, want to try sending packets
@@ -46,6 +73,31 @@ from one blockchain to another. To start a node using a specific config file:
 
   ignite chain serve --config mars.yml
 
+A running serve session can also be managed from other tools with a local
+HTTP/JSON control API that restarts the chain, resets its state, forces a
+rebuild, exports the genesis and reports the status of the session. The control
+API is enabled by setting its port in the config file:
+
+control:
+  port: 4501
+
+The nodes log in JSON, so their logs can be rendered and filtered. By default
+the logs are printed only with the verbose output. Use the following flags to
+print the logs of the nodes with a level for each module and to only print the
+lines that match a regular expression:
+
+  ignite chain serve --log x/mars:debug,p2p:error,*:info --log-match "height=\d+"
+
+To save the logs of the nodes in rotating files in the Ignite directory of the
+chain use the "--log-file" flag.
+
+To integrate the serve command with other tools, such as IDE plugins or CI
+scripts, the text output can be replaced with a stream of JSON events, one per
+line, that describe each lifecycle step of the chain (build, init, restore,
+start and export) with its status, addresses, timing and errors:
+
+  ignite chain serve --output json
+
 The serve command is meant to be used ONLY FOR DEVELOPMENT PURPOSES. Under the
 hood, it runs "appd start", where "appd" is the name of your chain's binary. For
 production, you may want to run "appd start" manually.
@@ -60,9 +112,13 @@ production, you may want to run "appd start" manually.
 	c.Flags().AddFlagSet(flagSetProto3rdParty(""))
 	c.Flags().AddFlagSet(flagSetCheckDependencies())
 	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetOutputFormat(flagOutput))
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
 	c.Flags().BoolP(flagForceReset, "f", false, "Force reset of the app state on start and every source change")
 	c.Flags().BoolP(flagResetOnce, "r", false, "Reset of the app state on first start")
+	c.Flags().String(flagFromSnapshot, "", "Restore the app state saved in a snapshot on first start")
+	c.Flags().AddFlagSet(flagSetFork())
+	c.Flags().AddFlagSet(flagSetNodeLogs())
 
 	return c
 }
@@ -80,6 +136,21 @@ func chainServeHandler(cmd *cobra.Command, args []string) error {
 		chainOption = append(chainOption, chain.CheckDependencies())
 	}
 
+	outputJSON, err := flagGetOutputJSON(cmd, flagOutput)
+	if err != nil {
+		return err
+	}
+	if outputJSON {
+		out := newEventOutput(cmd.OutOrStdout())
+		defer out.Close()
+
+		// the text output of the command is discarded to keep the output machine-readable
+		cmd.SetOut(io.Discard)
+
+		// text logs are disabled to keep the output machine-readable
+		chainOption = append(chainOption, chain.LogLevel(chain.LogSilent), chain.CollectEvents(out.Bus()))
+	}
+
 	// check if custom config is defined
 	config, err := cmd.Flags().GetString(flagConfig)
 	if err != nil {
@@ -117,9 +188,54 @@ func chainServeHandler(cmd *cobra.Command, args []string) error {
 		serveOptions = append(serveOptions, chain.ServeResetOnce())
 	}
 
+	fromSnapshot, err := cmd.Flags().GetString(flagFromSnapshot)
+	if err != nil {
+		return err
+	}
+	if fromSnapshot != "" {
+		serveOptions = append(serveOptions, chain.ServeFromSnapshot(fromSnapshot))
+	}
+
+	if fromExport, forkOptions := flagGetFork(cmd); fromExport != "" {
+		serveOptions = append(serveOptions, chain.ServeFromExport(fromExport, forkOptions...))
+	}
+
 	if flagGetSkipProto(cmd) {
 		serveOptions = append(serveOptions, chain.ServeSkipProto())
 	}
 
+	logOptions, err := flagGetNodeLogs(cmd)
+	if err != nil {
+		return err
+	}
+	serveOptions = append(serveOptions, logOptions...)
+
 	return c.Serve(cmd.Context(), cacheStorage, serveOptions...)
 }
+
+func flagSetNodeLogs() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagLog, "", "print the node logs with a level for each module, e.g. x/mars:debug,p2p:error,*:info")
+	fs.String(flagLogMatch, "", "print only the node log lines that match a regular expression")
+	fs.Bool(flagLogFile, false, "save the node logs in rotating files")
+	return fs
+}
+
+func flagGetNodeLogs(cmd *cobra.Command) (options []chain.ServeOption, err error) {
+	levels, _ := cmd.Flags().GetString(flagLog)
+	match, _ := cmd.Flags().GetString(flagLogMatch)
+	if levels != "" || match != "" {
+		filter, err := nodelog.NewFilter(nodelog.FilterLevels(levels), nodelog.FilterMatch(match))
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, chain.ServeLogFilter(filter))
+	}
+
+	if logFile, _ := cmd.Flags().GetBool(flagLogFile); logFile {
+		options = append(options, chain.ServeLogFile())
+	}
+
+	return options, nil
+}
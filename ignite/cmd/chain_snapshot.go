@@ -0,0 +1,61 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// NewChainSnapshot creates a new snapshot command that holds
+// sub commands to manage the saved states of a blockchain.
+func NewChainSnapshot() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "snapshot [command]",
+		Short: "Save, list, restore and delete snapshots of the chain state",
+		Long: `Commands in this namespace let you save named snapshots of the state of your
+blockchain during development and restore them later.
+
+A snapshot contains the exported genesis of the chain, the keys of the validator
+nodes and the keyring with the chain accounts. Optionally, the data directory of
+the nodes can be included in the snapshot, which allows the nodes to continue
+from the saved height instead of importing the exported genesis:
+
+  ignite chain snapshot save before-migration --data
+
+Snapshots are saved in the Ignite directory, separately for each chain ID, and
+they are not modified when the chain is served. To start serving the chain from
+a snapshot use the following flag:
+
+  ignite chain serve --from-snapshot before-migration
+
+The chain must be stopped while snapshots are saved or restored.
+`,
+		Aliases: []string{"s"},
+		Args:    cobra.ExactArgs(1),
+	}
+
+	flagSetPath(c)
+	c.PersistentFlags().AddFlagSet(flagSetHome())
+
+	c.AddCommand(NewChainSnapshotSave())
+	c.AddCommand(NewChainSnapshotList())
+	c.AddCommand(NewChainSnapshotRestore())
+	c.AddCommand(NewChainSnapshotDelete())
+
+	return c
+}
+
+// newChainSnapshotChain creates the chain used by the snapshot commands.
+func newChainSnapshotChain(cmd *cobra.Command) (*chain.Chain, error) {
+	chainOption := []chain.Option{
+		chain.LogLevel(logLevel(cmd)),
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+	}
+
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	return newChainWithHomeFlags(cmd, chainOption...)
+}
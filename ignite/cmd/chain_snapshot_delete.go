@@ -0,0 +1,36 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+)
+
+// NewChainSnapshotDelete creates a new command to delete a snapshot of the chain.
+func NewChainSnapshotDelete() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "delete [name]",
+		Short: "Delete a saved snapshot of the chain",
+		Args:  cobra.ExactArgs(1),
+		RunE:  chainSnapshotDeleteHandler,
+	}
+
+	return c
+}
+
+func chainSnapshotDeleteHandler(cmd *cobra.Command, args []string) error {
+	c, err := newChainSnapshotChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DeleteSnapshot(args[0]); err != nil {
+		return err
+	}
+
+	fmt.Printf("🗑  Snapshot %s deleted\n", colors.Info(args[0]))
+
+	return nil
+}
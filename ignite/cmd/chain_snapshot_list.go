@@ -0,0 +1,56 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/entrywriter"
+)
+
+var snapshotSummaryHeader = []string{"name", "height", "data", "created", "source checksum"}
+
+// NewChainSnapshotList creates a new command to list the snapshots of the chain.
+func NewChainSnapshotList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the saved snapshots of the chain",
+		Args:  cobra.NoArgs,
+		RunE:  chainSnapshotListHandler,
+	}
+
+	return c
+}
+
+func chainSnapshotListHandler(cmd *cobra.Command, _ []string) error {
+	c, err := newChainSnapshotChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := c.Snapshots()
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) == 0 {
+		fmt.Println("No snapshots found")
+		return nil
+	}
+
+	var entries [][]string
+	for _, s := range snapshots {
+		entries = append(entries, []string{
+			s.Name,
+			strconv.FormatInt(s.Height, 10),
+			strconv.FormatBool(s.HasData),
+			s.CreatedAt.Local().Format(time.RFC3339),
+			s.SourceChecksum,
+		})
+	}
+
+	return entrywriter.MustWrite(os.Stdout, snapshotSummaryHeader, entries...)
+}
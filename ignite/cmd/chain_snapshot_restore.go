@@ -0,0 +1,57 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// NewChainSnapshotRestore creates a new command to restore the chain state from a snapshot.
+func NewChainSnapshotRestore() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "restore [name]",
+		Short: "Restore the state of the chain saved in a snapshot",
+		Long: `Restore the state of the chain saved in a snapshot.
+
+The chain must be initialized before restoring a snapshot. The state of the
+chain is replaced with the one saved in the snapshot, so it's recommended to
+save the current state first if you want to keep it.
+
+Restoring a snapshot that was saved from a different version of the source code
+might fail when the chain is started if the saved state is not compatible.
+
+A snapshot saved from a chain with a different chain ID is rejected, use the
+"--force" flag to restore it anyway.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainSnapshotRestoreHandler,
+	}
+
+	c.Flags().Bool(flagForce, false, "Restore the snapshot even if it was saved from a chain with a different chain ID")
+
+	return c
+}
+
+func chainSnapshotRestoreHandler(cmd *cobra.Command, args []string) error {
+	c, err := newChainSnapshotChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	var options []chain.RestoreOption
+	if force, _ := cmd.Flags().GetBool(flagForce); force {
+		options = append(options, chain.RestoreForce())
+	}
+
+	s, err := c.RestoreSnapshot(cmd.Context(), args[0], options...)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("💿 Snapshot %s restored at height %d\n", colors.Info(s.Name), s.Height)
+
+	return nil
+}
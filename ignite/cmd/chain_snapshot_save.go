@@ -0,0 +1,51 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const flagSnapshotData = "data"
+
+// NewChainSnapshotSave creates a new command to save a snapshot of the chain state.
+func NewChainSnapshotSave() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "save [name]",
+		Short: "Save the current state of the chain in a snapshot",
+		Args:  cobra.ExactArgs(1),
+		RunE:  chainSnapshotSaveHandler,
+	}
+
+	c.Flags().Bool(flagSnapshotData, false, "Include the data directory of the nodes in the snapshot")
+
+	return c
+}
+
+func chainSnapshotSaveHandler(cmd *cobra.Command, args []string) error {
+	c, err := newChainSnapshotChain(cmd)
+	if err != nil {
+		return err
+	}
+
+	var options []chain.SnapshotOption
+	withData, err := cmd.Flags().GetBool(flagSnapshotData)
+	if err != nil {
+		return err
+	}
+	if withData {
+		options = append(options, chain.SnapshotWithData())
+	}
+
+	s, err := c.SaveSnapshot(cmd.Context(), args[0], options...)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("💿 Snapshot %s saved at height %d\n", colors.Info(s.Name), s.Height)
+
+	return nil
+}
@@ -56,8 +56,9 @@ ignite scaffold chain github.com/username/mars`,
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			// Check for new versions only when shell completion scripts are not being
-			// generated to avoid invalid output to stdout when a new version is available
-			if cmd.Use != "completions" {
+			// generated to avoid invalid output to stdout when a new version is available,
+			// or when the output of the command is JSON to keep it machine-readable
+			if cmd.Use != "completions" && !isOutputJSON(cmd) {
 				checkNewVersion(cmd.Context())
 			}
 
@@ -117,6 +118,7 @@ func getHome(cmd *cobra.Command) (home string) {
 func flagSetConfig() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.StringP(flagConfig, "c", "", "ignite config file (default: ./config.yml)")
+	fs.String(flagProfile, "", "config profile that overrides the config file values with the values of config.<profile>.yml")
 	return fs
 }
 
@@ -125,6 +127,11 @@ func getConfig(cmd *cobra.Command) (config string) {
 	return
 }
 
+func getProfile(cmd *cobra.Command) (profile string) {
+	profile, _ = cmd.Flags().GetString(flagProfile)
+	return
+}
+
 func flagSetYes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.BoolP(flagYes, "y", false, "answers interactive yes/no questions with yes")
@@ -179,6 +186,11 @@ func newChainWithHomeFlags(cmd *cobra.Command, chainOption ...chain.Option) (*ch
 		chainOption = append(chainOption, chain.HomePath(home))
 	}
 
+	// Check if a config profile is selected
+	if profile := getProfile(cmd); profile != "" {
+		chainOption = append(chainOption, chain.ConfigProfile(profile))
+	}
+
 	appPath := flagGetPath(cmd)
 	absPath, err := filepath.Abs(appPath)
 	if err != nil {
@@ -0,0 +1,78 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+func flagSetOutputFormat(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(name, outputText, fmt.Sprintf("output format (%s|%s)", outputText, outputJSON))
+	return fs
+}
+
+// flagGetOutputJSON checks if the output format flag with the given name is set to JSON.
+func flagGetOutputJSON(cmd *cobra.Command, name string) (bool, error) {
+	format, _ := cmd.Flags().GetString(name)
+	switch format {
+	case outputText:
+		return false, nil
+	case outputJSON:
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid output format %q, use %q or %q", format, outputText, outputJSON)
+	}
+}
+
+// isOutputJSON checks if the output format flag of a command is set to JSON.
+func isOutputJSON(cmd *cobra.Command) bool {
+	f := cmd.Flags().Lookup(flagOutput)
+	return f != nil && f.Value.String() == outputJSON
+}
+
+// eventOutput writes lifecycle events to an output as JSON lines.
+type eventOutput struct {
+	bus  events.LifecycleBus
+	done chan struct{}
+}
+
+func newEventOutput(w io.Writer) eventOutput {
+	o := eventOutput{
+		bus:  events.NewLifecycleBus(),
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(o.done)
+
+		enc := json.NewEncoder(w)
+		for e := range o.bus.Events() {
+			// Events are written one per line so they can be consumed while the command runs
+			_ = enc.Encode(e)
+		}
+	}()
+
+	return o
+}
+
+// Bus returns the bus where the events to write are sent.
+func (o eventOutput) Bus() events.LifecycleBus {
+	return o.bus
+}
+
+// Close waits until all the sent events are written.
+func (o eventOutput) Close() {
+	o.bus.Shutdown()
+	<-o.done
+}
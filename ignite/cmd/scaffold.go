@@ -3,6 +3,8 @@ package ignitecmd
 import (
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/pkg/xgit"
 	"github.com/ignite/cli/ignite/services/scaffolder"
@@ -78,10 +81,20 @@ with a proto message description. Note that proto messages produce (and
 correspond with) Go types whereas Cosmos SDK messages correspond to proto "rpc"
 in the "Msg" service.
 
+Modules can notify clients of state transitions with typed events. The event
+scaffolding command creates a proto message for the event and a keeper function
+to emit it, optionally from the handlers of scaffolded messages.
+
 If you're building an application with custom IBC logic, you might need to
 scaffold IBC packets. An IBC packet represents the data sent from one blockchain
 to another. You can only scaffold IBC packets in IBC-enabled modules scaffolded
 with an "--ibc" flag. Note that the default module is not IBC-enabled.
+
+To integrate scaffolding with other tools, the text output can be replaced with
+JSON events, one per line, that describe the scaffolding status, timing and
+errors:
+
+  ignite scaffold message create-post title --output json
 `,
 		Aliases: []string{"s"},
 		Args:    cobra.ExactArgs(1),
@@ -95,15 +108,61 @@ with an "--ibc" flag. Note that the default module is not IBC-enabled.
 	c.AddCommand(NewScaffoldType())
 	c.AddCommand(NewScaffoldMessage())
 	c.AddCommand(NewScaffoldQuery())
+	c.AddCommand(NewScaffoldEvent())
 	c.AddCommand(NewScaffoldPacket())
 	c.AddCommand(NewScaffoldBandchain())
 	c.AddCommand(NewScaffoldVue())
 	c.AddCommand(NewScaffoldFlutter())
 	// c.AddCommand(NewScaffoldWasm())
 
+	c.PersistentFlags().AddFlagSet(flagSetOutputFormat(flagOutput))
+
+	for _, cmd := range c.Commands() {
+		if cmd.RunE != nil {
+			cmd.RunE = scaffoldEventsHandler(cmd.RunE)
+		}
+	}
+
 	return c
 }
 
+// scaffoldEventsHandler wraps a scaffold command handler to replace
+// its text output with lifecycle events when JSON output is enabled.
+func scaffoldEventsHandler(handler func(*cobra.Command, []string) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		outputJSON, err := flagGetOutputJSON(cmd, flagOutput)
+		if err != nil {
+			return err
+		}
+		if !outputJSON {
+			return handler(cmd, args)
+		}
+
+		out := newEventOutput(cmd.OutOrStdout())
+		defer out.Close()
+
+		// the text output of the handler is discarded to keep the output machine-readable
+		cmd.SetOut(io.Discard)
+
+		message := events.Message(strings.Join(append([]string{cmd.Name()}, args...), " "))
+		e := events.NewLifecycle(events.PhaseScaffold, events.PhaseStarted, message)
+		out.Bus().Send(e)
+
+		status := events.PhaseSucceeded
+		options := []events.LifecycleOption{message}
+
+		err = handler(cmd, args)
+		if err != nil {
+			status = events.PhaseFailed
+			options = append(options, events.Failure(err))
+		}
+
+		out.Bus().Send(events.NewLifecycle(events.PhaseScaffold, status, append(options, events.Elapsed(e.Time))...))
+
+		return err
+	}
+}
+
 func scaffoldType(
 	cmd *cobra.Command,
 	args []string,
@@ -138,7 +197,7 @@ func scaffoldType(
 		}
 	}
 
-	s := clispinner.New().SetText("Scaffolding...")
+	s := clispinner.New(clispinner.WithWriter(cmd.OutOrStdout())).SetText("Scaffolding...")
 	defer s.Stop()
 
 	sc, err := newApp(appPath)
@@ -163,8 +222,8 @@ func scaffoldType(
 		return err
 	}
 
-	fmt.Println(modificationsStr)
-	fmt.Printf("\n🎉 %s added. \n\n", typeName)
+	fmt.Fprintln(cmd.OutOrStdout(), modificationsStr)
+	fmt.Fprintf(cmd.OutOrStdout(), "\n🎉 %s added. \n\n", typeName)
 
 	return nil
 }
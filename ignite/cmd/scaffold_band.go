@@ -39,7 +39,7 @@ func createBandchainHandler(cmd *cobra.Command, args []string) error {
 		signer  = flagGetSigner(cmd)
 	)
 
-	s := clispinner.New().SetText("Scaffolding...")
+	s := clispinner.New(clispinner.WithWriter(cmd.OutOrStdout())).SetText("Scaffolding...")
 	defer s.Stop()
 
 	module, err := cmd.Flags().GetString(flagModule)
@@ -77,9 +77,9 @@ func createBandchainHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Println(modificationsStr)
+	fmt.Fprintln(cmd.OutOrStdout(), modificationsStr)
 
-	fmt.Printf(`
+	fmt.Fprintf(cmd.OutOrStdout(), `
 🎉 Created a Band oracle query "%[1]v".
 
 Note: BandChain module uses version "bandchain-1".
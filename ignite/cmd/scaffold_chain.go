@@ -73,7 +73,7 @@ about Cosmos SDK on https://docs.cosmos.network
 }
 
 func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Scaffolding...")
+	s := clispinner.New(clispinner.WithWriter(cmd.OutOrStdout())).SetText("Scaffolding...")
 	defer s.Stop()
 
 	var (
@@ -109,7 +109,7 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 
 Documentation: https://docs.ignite.com
 `
-	fmt.Printf(message, path)
+	fmt.Fprintf(cmd.OutOrStdout(), message, path)
 
 	return nil
 }
@@ -0,0 +1,98 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+)
+
+const flagEmitFrom = "emit-from"
+
+// NewScaffoldEvent returns the command to scaffold typed events
+func NewScaffoldEvent() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "event [name] [field1] [field2] ...",
+		Short: "Typed event emitted by the module",
+		Long: `Event scaffolding adds a typed event to a module. Typed events are proto
+messages emitted with the event manager of the Cosmos SDK, the event type is the
+full name of the proto message and the attributes are the JSON encoded fields.
+
+  ignite scaffold event post-created id:uint title creator:address --module blog
+
+The command above will create a new event EventPostCreated with three fields:
+id (an unsigned integer), title (a string) and creator (an address). The event
+is defined as a proto message in "proto/{app}/{module}/event_post_created.proto"
+and an "EmitPostCreatedEvent" function is added to the keeper of the module.
+
+Use the "--emit-from" flag to emit the event from the handlers of messages
+scaffolded in the module. The event is emitted before the response of the
+handler is returned, the fields of the event are set in the handler:
+
+  ignite scaffold event post-created id:uint title --module blog --emit-from createPost
+
+The TypeScript client exports the events of each module with a "decodeEvent"
+function to decode them from the events of a transaction result.
+`,
+		Args:    cobra.MinimumNArgs(1),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    eventHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "Module to add the event into. Default: app's main module")
+	c.Flags().StringSlice(flagEmitFrom, []string{}, "Messages of the module emitting the event")
+
+	return c
+}
+
+func eventHandler(cmd *cobra.Command, args []string) error {
+	appPath := flagGetPath(cmd)
+
+	s := clispinner.New(clispinner.WithWriter(cmd.OutOrStdout())).SetText("Scaffolding...")
+	defer s.Stop()
+
+	// Get the module to add the event into
+	module, err := cmd.Flags().GetString(flagModule)
+	if err != nil {
+		return err
+	}
+
+	// Get the messages emitting the event
+	emitFrom, err := cmd.Flags().GetStringSlice(flagEmitFrom)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddEvent(cmd.Context(), cacheStorage, placeholder.New(), module, args[0], args[1:], emitFrom)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), modificationsStr)
+	fmt.Fprintf(cmd.OutOrStdout(), "\n🎉 Created an event `%[1]v`.\n\n", args[0])
+
+	return nil
+}
@@ -26,7 +26,7 @@ func NewScaffoldFlutter() *cobra.Command {
 }
 
 func scaffoldFlutterHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Scaffolding...")
+	s := clispinner.New(clispinner.WithWriter(cmd.OutOrStdout())).SetText("Scaffolding...")
 	defer s.Stop()
 
 	path := flagGetPath(cmd)
@@ -35,7 +35,7 @@ func scaffoldFlutterHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	s.Stop()
-	fmt.Printf("\n🎉 Scaffold a Flutter app.\n\n")
+	fmt.Fprintf(cmd.OutOrStdout(), "\n🎉 Scaffold a Flutter app.\n\n")
 
 	return nil
 }
@@ -50,11 +50,18 @@ business logic you will implement next.
 
 By default, all fields are assumed to be strings. If you want a field of a
 different type, you can specify it after a colon ":". The following types are
-supported: string, bool, int, uint, coin, array.string, array.int, array.uint,
-array.coin. An example of using custom types:
+supported: string, bool, int, uint, int64, uint64, coin, address, dec, bytes,
+timestamp, duration, array.string, array.int, array.uint, array.coin. An example
+of using custom types:
 
   ignite scaffold list pool amount:coin tags:array.string height:int
   
+  ignite scaffold list order owner:address price:dec expiration:timestamp
+  
+An enum field has a fixed set of values separated by "|":
+
+  ignite scaffold list order 'status:enum:Pending|Active|Closed'
+  
 Ignite also supports custom types:
   
   ignite scaffold list product-details name description
@@ -62,8 +69,16 @@ Ignite also supports custom types:
   ignite scaffold list product price:coin details:ProductDetails
 
 In the example above the "ProductDetails" type was defined first, and then used
-as a custom type for the "details" field. Ignite doesn't support arrays of
-custom types yet.
+as a custom type for the "details" field. Arrays and maps of custom types are
+supported with "array.Type" and "map.key.Type", the map keys are strings or
+integers:
+
+  ignite scaffold list catalog products:array.ProductDetails featured:map.string.ProductDetails
+
+Fields can have validation rules after their type, separated by commas. The
+rules are checked by the messages and the errors are added to the module:
+
+  ignite scaffold list post title:string:required,max=64 price:coin:positive rate:dec:range=0..1
 
 By default the code will be scaffolded in the module that matches your project's
 name. If you have several modules in your project, you might want to specify a
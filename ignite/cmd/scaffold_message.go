@@ -89,7 +89,7 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		withoutSimulation = flagGetNoSimulation(cmd)
 	)
 
-	s := clispinner.New().SetText("Scaffolding...")
+	s := clispinner.New(clispinner.WithWriter(cmd.OutOrStdout())).SetText("Scaffolding...")
 	defer s.Stop()
 
 	cacheStorage, err := newCache(cmd)
@@ -131,8 +131,8 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Println(modificationsStr)
-	fmt.Printf("\n🎉 Created a message `%[1]v`.\n\n", args[0])
+	fmt.Fprintln(cmd.OutOrStdout(), modificationsStr)
+	fmt.Fprintf(cmd.OutOrStdout(), "\n🎉 Created a message `%[1]v`.\n\n", args[0])
 
 	return nil
 }
@@ -111,7 +111,7 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		name    = args[0]
 		appPath = flagGetPath(cmd)
 	)
-	s := clispinner.New().SetText("Scaffolding...")
+	s := clispinner.New(clispinner.WithWriter(cmd.OutOrStdout())).SetText("Scaffolding...")
 	defer s.Stop()
 
 	ibcModule, err := cmd.Flags().GetBool(flagIBC)
@@ -197,11 +197,11 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		fmt.Println(modificationsStr)
+		fmt.Fprintln(cmd.OutOrStdout(), modificationsStr)
 	}
 
 	if len(dependencies) > 0 {
-		dependencyWarning(dependencies)
+		dependencyWarning(cmd.OutOrStdout(), dependencies)
 	}
 
 	io.Copy(cmd.OutOrStdout(), &msg)
@@ -220,10 +220,10 @@ app.GovKeeper = ...
 `
 
 // dependencyWarning is used to print a warning if gov is provided as a dependency
-func dependencyWarning(dependencies []string) {
+func dependencyWarning(out io.Writer, dependencies []string) {
 	for _, dep := range dependencies {
 		if dep == "gov" {
-			fmt.Print(govWarning)
+			fmt.Fprint(out, govWarning)
 		}
 	}
 }
@@ -26,7 +26,7 @@ func NewScaffoldWasm() *cobra.Command {
 func scaffoldWasmHandler(cmd *cobra.Command, args []string) error {
 	appPath := flagGetPath(cmd)
 
-	s := clispinner.New().SetText("Scaffolding...")
+	s := clispinner.New(clispinner.WithWriter(cmd.OutOrStdout())).SetText("Scaffolding...")
 	defer s.Stop()
 
 	cacheStorage, err := newCache(cmd)
@@ -51,8 +51,8 @@ func scaffoldWasmHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Println(modificationsStr)
-	fmt.Printf("\n🎉 Imported wasm.\n\n")
+	fmt.Fprintln(cmd.OutOrStdout(), modificationsStr)
+	fmt.Fprintf(cmd.OutOrStdout(), "\n🎉 Imported wasm.\n\n")
 
 	return nil
 }
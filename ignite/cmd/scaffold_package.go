@@ -39,7 +39,7 @@ func NewScaffoldPacket() *cobra.Command {
 }
 
 func createPacketHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Scaffolding...")
+	s := clispinner.New(clispinner.WithWriter(cmd.OutOrStdout())).SetText("Scaffolding...")
 	defer s.Stop()
 
 	var (
@@ -96,8 +96,8 @@ func createPacketHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Println(modificationsStr)
-	fmt.Printf("\n🎉 Created a packet `%[1]v`.\n\n", args[0])
+	fmt.Fprintln(cmd.OutOrStdout(), modificationsStr)
+	fmt.Fprintf(cmd.OutOrStdout(), "\n🎉 Created a packet `%[1]v`.\n\n", args[0])
 
 	return nil
 }
@@ -26,7 +26,7 @@ func NewScaffoldVue() *cobra.Command {
 }
 
 func scaffoldVueHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Scaffolding...")
+	s := clispinner.New(clispinner.WithWriter(cmd.OutOrStdout())).SetText("Scaffolding...")
 	defer s.Stop()
 
 	path := flagGetPath(cmd)
@@ -35,7 +35,7 @@ func scaffoldVueHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	s.Stop()
-	fmt.Printf("\n🎉 Scaffold a Vue.js app.\n\n")
+	fmt.Fprintf(cmd.OutOrStdout(), "\n🎉 Scaffold a Vue.js app.\n\n")
 
 	return nil
 }
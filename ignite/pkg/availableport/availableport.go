@@ -32,3 +32,13 @@ func Find(n int) (ports []int, err error) {
 	}
 	return ports, nil
 }
+
+// IsAvailable checks if a port is not used by any program to listen at.
+func IsAvailable(port int) bool {
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	l.Close()
+	return true
+}
@@ -18,6 +18,7 @@ const (
 	commandCollectGentxs     = "collect-gentxs"
 	commandValidateGenesis   = "validate-genesis"
 	commandShowNodeID        = "show-node-id"
+	commandShowValidator     = "show-validator"
 	commandStatus            = "status"
 	commandTx                = "tx"
 	commandQuery             = "query"
@@ -49,6 +50,8 @@ const (
 	optionVestingAmount                    = "--vesting-amount"
 	optionVestingEndTime                   = "--vesting-end-time"
 	optionBroadcastMode                    = "--broadcast-mode"
+	optionNodeID                           = "--node-id"
+	optionPubKey                           = "--pubkey"
 
 	constTendermint = "tendermint"
 	constJSON       = "json"
@@ -403,6 +406,26 @@ func GentxWithSecurityContact(securityContact string) GentxOption {
 	}
 }
 
+// GentxWithNodeID provides the node ID option for the gentx command
+func GentxWithNodeID(nodeID string) GentxOption {
+	return func(command []string) []string {
+		if len(nodeID) > 0 {
+			return append(command, optionNodeID, nodeID)
+		}
+		return command
+	}
+}
+
+// GentxWithPubKey provides the validator consensus public key option for the gentx command
+func GentxWithPubKey(pubKey string) GentxOption {
+	return func(command []string) []string {
+		if len(pubKey) > 0 {
+			return append(command, optionPubKey, pubKey)
+		}
+		return command
+	}
+}
+
 func (c ChainCmd) IsAutoChainIDDetectionEnabled() bool {
 	return c.isAutoChainIDDetectionEnabled
 }
@@ -487,6 +510,15 @@ func (c ChainCmd) ShowNodeIDCommand() step.Option {
 	return c.daemonCommand(command)
 }
 
+// ShowValidatorCommand returns the command to print the validator consensus public key of the node for the chain
+func (c ChainCmd) ShowValidatorCommand() step.Option {
+	command := []string{
+		constTendermint,
+		commandShowValidator,
+	}
+	return c.daemonCommand(command)
+}
+
 // UnsafeResetCommand returns the command to reset the blockchain database
 func (c ChainCmd) UnsafeResetCommand() step.Option {
 	var command []string
@@ -540,6 +572,17 @@ func (c ChainCmd) BankSendCommand(fromAddress, toAddress, amount string) step.Op
 	return c.cliCommand(command)
 }
 
+// TxEncodeCommand returns the command to encode a JSON transaction from a file into protobuf
+func (c ChainCmd) TxEncodeCommand(txFile string) step.Option {
+	command := []string{
+		commandTx,
+		"encode",
+		txFile,
+	}
+
+	return c.cliCommand(command)
+}
+
 // QueryTxCommand returns the command to query tx
 func (c ChainCmd) QueryTxCommand(txHash string) step.Option {
 	command := []string{
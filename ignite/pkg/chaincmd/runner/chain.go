@@ -3,6 +3,7 @@ package chaincmdrunner
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -112,6 +113,14 @@ func (r Runner) ShowNodeID(ctx context.Context) (nodeID string, err error) {
 	return
 }
 
+// ShowValidator shows the validator consensus public key of the node.
+func (r Runner) ShowValidator(ctx context.Context) (pubKey string, err error) {
+	b := &bytes.Buffer{}
+	err = r.run(ctx, runOptions{stdout: b}, r.chainCmd.ShowValidatorCommand())
+	pubKey = strings.TrimSpace(b.String())
+	return
+}
+
 // NodeStatus keeps info about node's status.
 type NodeStatus struct {
 	ChainID string
@@ -260,6 +269,16 @@ func (r Runner) Export(ctx context.Context, exportedFile string) error {
 	return os.WriteFile(exportedFile, exportedState, 0o644)
 }
 
+// EncodeTx encodes a JSON transaction from a file and returns the transaction encoded in protobuf.
+func (r Runner) EncodeTx(ctx context.Context, txFile string) ([]byte, error) {
+	b := &bytes.Buffer{}
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.TxEncodeCommand(txFile)); err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(b.String()))
+}
+
 // EventSelector is used to query events.
 type EventSelector struct {
 	typ   string
@@ -6,6 +6,8 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/mod/semver"
 
@@ -15,6 +17,9 @@ import (
 	"github.com/ignite/cli/ignite/pkg/protoanalysis"
 )
 
+// eventPrefix is the name prefix of the proto messages used as typed events.
+const eventPrefix = "Event"
+
 // Msgs is a module import path-sdk msgs pair.
 type Msgs map[string][]string
 
@@ -37,6 +42,9 @@ type Module struct {
 
 	// Types is a list of proto types that might be used by module.
 	Types []Type
+
+	// Events is a list of typed events emitted by the module.
+	Events []Event
 }
 
 // Msg keeps metadata about an sdk.Msg implementation.
@@ -71,6 +79,18 @@ type Type struct {
 	FilePath string
 }
 
+// Event is a typed event, a proto message emitted with the event manager of the SDK.
+type Event struct {
+	// Name of the type.
+	Name string
+
+	// URI of the type, used as the type of the emitted events.
+	URI string
+
+	// FilePath is the path of the .proto file where message is defined at.
+	FilePath string
+}
+
 type moduleDiscoverer struct {
 	sourcePath        string
 	protoPath         string
@@ -285,6 +305,14 @@ func (d *moduleDiscoverer) discover(pkg protoanalysis.Package) (Module, error) {
 			Name:     protomsg.Name,
 			FilePath: protomsg.Path,
 		})
+
+		if isEventName(protomsg.Name) {
+			m.Events = append(m.Events, Event{
+				Name:     protomsg.Name,
+				URI:      fmt.Sprintf("%s.%s", pkg.Name, protomsg.Name),
+				FilePath: protomsg.Path,
+			})
+		}
 	}
 
 	// fill queries.
@@ -304,6 +332,17 @@ func (d *moduleDiscoverer) discover(pkg protoanalysis.Package) (Module, error) {
 	return m, nil
 }
 
+// isEventName checks if a proto message name is the name of a typed event.
+// Typed events are named with an "Event" prefix followed by an upper-case letter
+// by convention, e.g. EventPostCreated, so names like Eventually are not events.
+func isEventName(name string) bool {
+	if !strings.HasPrefix(name, eventPrefix) {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(name[len(eventPrefix):])
+	return unicode.IsUpper(r)
+}
+
 func (d *moduleDiscoverer) findModuleProtoPkgs(ctx context.Context) ([]protoanalysis.Package, error) {
 	// find out all proto packages inside blockchain.
 	allprotopkgs, err := protoanalysis.Parse(ctx, nil, d.protoPath)
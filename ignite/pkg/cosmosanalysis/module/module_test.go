@@ -227,3 +227,26 @@ func TestRootGoImportPath(t *testing.T) {
 		})
 	}
 }
+
+func TestDiscoverEvents(t *testing.T) {
+	ctx := context.Background()
+	sourcePath := "testdata/planet_events"
+	protoPath := filepath.Join(sourcePath, "proto/planet/mars/mars.proto")
+
+	modules, err := module.Discover(ctx, sourcePath, sourcePath, "proto")
+
+	require.NoError(t, err)
+	require.Len(t, modules, 1)
+	require.Equal(t, []module.Type{
+		{Name: "EventRoverLanded", FilePath: protoPath},
+		{Name: "Eventually", FilePath: protoPath},
+		{Name: "Event", FilePath: protoPath},
+	}, modules[0].Types)
+	require.Equal(t, []module.Event{
+		{
+			Name:     "EventRoverLanded",
+			URI:      "tendermint.planet.mars.EventRoverLanded",
+			FilePath: protoPath,
+		},
+	}, modules[0].Events)
+}
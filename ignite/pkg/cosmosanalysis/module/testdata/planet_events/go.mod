@@ -0,0 +1,4 @@
+module github.com/tendermint/planet
+
+go 1.16
+
@@ -0,0 +1,6 @@
+package types
+
+type (
+	QueryMyQueryRequest  struct{}
+	QueryMyQueryResponse struct{}
+)
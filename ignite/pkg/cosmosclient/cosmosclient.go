@@ -409,6 +409,29 @@ func (c Client) WaitForTx(ctx context.Context, hash string) (*ctypes.ResultTx, e
 	}
 }
 
+// DeliveredTxs returns the txs delivered between the fromHeight and toHeight blocks, both
+// included, in the order they were delivered. The node must have the tx indexer enabled.
+func (c Client) DeliveredTxs(ctx context.Context, fromHeight, toHeight int64) ([]*ctypes.ResultTx, error) {
+	var (
+		txs     []*ctypes.ResultTx
+		query   = fmt.Sprintf("tx.height>=%d AND tx.height<=%d", fromHeight, toHeight)
+		perPage = 100
+	)
+
+	for page := 1; ; page++ {
+		resp, err := c.RPC.TxSearch(ctx, query, false, &page, &perPage, "asc")
+		if err != nil {
+			return nil, errors.Wrapf(err, "searching txs between blocks %d and %d", fromHeight, toHeight)
+		}
+
+		txs = append(txs, resp.Txs...)
+
+		if len(resp.Txs) == 0 || len(txs) >= resp.TotalCount {
+			return txs, nil
+		}
+	}
+}
+
 // Account returns the account with name or address equal to nameOrAddress.
 func (c Client) Account(nameOrAddress string) (cosmosaccount.Account, error) {
 	defer c.lockBech32Prefix()()
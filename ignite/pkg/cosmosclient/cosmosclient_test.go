@@ -244,6 +244,67 @@ func TestClientWaitForTx(t *testing.T) {
 	}
 }
 
+func TestClientDeliveredTxs(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		query = "tx.height>=2 AND tx.height<=5"
+		tx1   = &ctypes.ResultTx{Height: 2}
+		tx2   = &ctypes.ResultTx{Height: 3}
+		tx3   = &ctypes.ResultTx{Height: 5}
+	)
+	isPage := func(n int) interface{} {
+		return mock.MatchedBy(func(page *int) bool { return *page == n })
+	}
+	tests := []struct {
+		name           string
+		expectedError  string
+		expectedResult []*ctypes.ResultTx
+		setup          func(suite)
+	}{
+		{
+			name: "ok: no txs",
+			setup: func(s suite) {
+				s.rpcClient.EXPECT().TxSearch(ctx, query, false, isPage(1), mock.Anything, "asc").
+					Return(&ctypes.ResultTxSearch{}, nil).Once()
+			},
+		},
+		{
+			name:           "ok: txs in multiple pages",
+			expectedResult: []*ctypes.ResultTx{tx1, tx2, tx3},
+			setup: func(s suite) {
+				s.rpcClient.EXPECT().TxSearch(ctx, query, false, isPage(1), mock.Anything, "asc").
+					Return(&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{tx1, tx2}, TotalCount: 3}, nil).Once()
+				s.rpcClient.EXPECT().TxSearch(ctx, query, false, isPage(2), mock.Anything, "asc").
+					Return(&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{tx3}, TotalCount: 3}, nil).Once()
+			},
+		},
+		{
+			name:          "fail: search returns an error",
+			expectedError: "searching txs between blocks 2 and 5: error while requesting node 'http://localhost:26657': oups",
+			setup: func(s suite) {
+				s.rpcClient.EXPECT().TxSearch(ctx, query, false, isPage(1), mock.Anything, "asc").
+					Return(nil, errors.New("oups")).Once()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			assert := assert.New(t)
+			c := newClient(t, tt.setup)
+
+			res, err := c.DeliveredTxs(ctx, 2, 5)
+
+			if tt.expectedError != "" {
+				require.EqualError(err, tt.expectedError)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.expectedResult, res)
+		})
+	}
+}
+
 func TestClientAccount(t *testing.T) {
 	var (
 		accountName = "bob"
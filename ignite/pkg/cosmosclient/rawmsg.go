@@ -0,0 +1,57 @@
+package cosmosclient
+
+import (
+	"fmt"
+	"strings"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdktypes.Msg = &RawMsg{}
+
+// RawMsg is a transaction message that is already encoded in protobuf.
+// It allows to broadcast messages which Go types are not registered in the
+// client, like the messages of the custom modules of a chain.
+type RawMsg struct {
+	// TypeURL is the protobuf type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend.
+	TypeURL string
+
+	// Value is the message encoded in protobuf.
+	Value []byte
+}
+
+// NewRawMsg creates a new message from its type URL and its protobuf encoded value.
+func NewRawMsg(typeURL string, value []byte) *RawMsg {
+	return &RawMsg{
+		TypeURL: typeURL,
+		Value:   value,
+	}
+}
+
+func (m *RawMsg) Reset() { *m = RawMsg{} }
+
+func (m *RawMsg) String() string { return fmt.Sprintf("%s: %x", m.TypeURL, m.Value) }
+
+func (*RawMsg) ProtoMessage() {}
+
+// XXX_MessageName returns the protobuf name of the message.
+// It's used to set the type URL when the message is packed into a transaction.
+func (m *RawMsg) XXX_MessageName() string { //nolint: revive,stylecheck
+	return strings.TrimPrefix(m.TypeURL, "/")
+}
+
+// Marshal returns the protobuf encoded message.
+func (m *RawMsg) Marshal() ([]byte, error) {
+	return m.Value, nil
+}
+
+// ValidateBasic doesn't validate the message because its Go type is unknown.
+// The message is validated by the chain when the transaction is delivered.
+func (*RawMsg) ValidateBasic() error {
+	return nil
+}
+
+// GetSigners returns no signers because they can't be read from the encoded message.
+func (*RawMsg) GetSigners() []sdktypes.AccAddress {
+	return nil
+}
@@ -0,0 +1,32 @@
+package cosmosclient_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+func TestRawMsg(t *testing.T) {
+	// Arrange
+	msg := &banktypes.MsgSend{
+		FromAddress: "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw",
+		ToAddress:   "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw",
+	}
+	value, err := msg.Marshal()
+	require.NoError(t, err)
+
+	want, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+
+	// Act
+	got, err := codectypes.NewAnyWithValue(cosmosclient.NewRawMsg("/cosmos.bank.v1beta1.MsgSend", value))
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, want.TypeUrl, got.TypeUrl)
+	require.Equal(t, want.Value, got.Value)
+}
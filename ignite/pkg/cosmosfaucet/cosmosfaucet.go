@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
 )
 
 const (
@@ -55,6 +56,9 @@ type Faucet struct {
 	// it holds the maximum amounts of coins that can be sent to a single account.
 	coinsMax map[string]uint64
 
+	// denomMetadata holds the metadata of the denoms, it's used to display the amounts of the coins.
+	denomMetadata map[string]genesis.DenomMetadata
+
 	limitRefreshWindow time.Duration
 
 	// openAPIData holds template data customizations for serving OpenAPI page & spec.
@@ -87,6 +91,13 @@ func Coin(amount, maxAmount uint64, denom string) Option {
 	}
 }
 
+// DenomMetadata adds the metadata of a denom that is used to display the amounts of its coins.
+func DenomMetadata(metadata genesis.DenomMetadata) Option {
+	return func(f *Faucet) {
+		f.denomMetadata[metadata.Base] = metadata
+	}
+}
+
 // RefreshWindow adds the duration to refresh the transfer limit to the faucet
 func RefreshWindow(refreshWindow time.Duration) Option {
 	return func(f *Faucet) {
@@ -111,10 +122,11 @@ func OpenAPI(apiAddress string) Option {
 // New creates a new faucet with ccr (to access and use blockchain's CLI) and given options.
 func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Faucet, error) {
 	f := Faucet{
-		runner:      ccr,
-		accountName: DefaultAccountName,
-		coinsMax:    make(map[string]uint64),
-		openAPIData: openAPIData{"Blockchain", "http://localhost:1317"},
+		runner:        ccr,
+		accountName:   DefaultAccountName,
+		coinsMax:      make(map[string]uint64),
+		denomMetadata: make(map[string]genesis.DenomMetadata),
+		openAPIData:   openAPIData{"Blockchain", "http://localhost:1317"},
 	}
 
 	for _, apply := range options {
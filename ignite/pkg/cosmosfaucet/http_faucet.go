@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
 	"github.com/ignite/cli/ignite/pkg/xhttp"
 )
 
@@ -65,15 +68,107 @@ type FaucetInfoResponse struct {
 
 	// ChainID is chain id of the chain that faucet is running for.
 	ChainID string `json:"chain_id"`
+
+	// Coins are the coins distributed by the faucet on each request.
+	Coins []FaucetInfoCoin `json:"coins,omitempty"`
+}
+
+// FaucetInfoCoin is a coin distributed by the faucet.
+// The display fields are set when the metadata of the denom is known.
+type FaucetInfoCoin struct {
+	// Denom is the base denom of the coin.
+	Denom string `json:"denom"`
+
+	// Amount is the amount of the coin distributed on each request.
+	Amount string `json:"amount"`
+
+	// MaxAmount is the maximum amount of the coin that can be sent to a single account.
+	MaxAmount string `json:"max_amount,omitempty"`
+
+	// DisplayDenom is the denom used to display the amounts, e.g. atom.
+	DisplayDenom string `json:"display_denom,omitempty"`
+
+	// DisplayAmount is the amount in the display denom, e.g. 10.5.
+	DisplayAmount string `json:"display_amount,omitempty"`
+
+	// DisplayMaxAmount is the max amount in the display denom.
+	DisplayMaxAmount string `json:"display_max_amount,omitempty"`
+
+	// Symbol is the symbol of the coin, e.g. ATOM.
+	Symbol string `json:"symbol,omitempty"`
 }
 
 func (f Faucet) faucetInfoHandler(w http.ResponseWriter, r *http.Request) {
 	xhttp.ResponseJSON(w, http.StatusOK, FaucetInfoResponse{
 		IsAFaucet: true,
 		ChainID:   f.chainID,
+		Coins:     f.coinsInfo(),
 	})
 }
 
+// coinsInfo returns the coins distributed by the faucet with their display amounts.
+func (f Faucet) coinsInfo() []FaucetInfoCoin {
+	var coins []FaucetInfoCoin
+	for _, c := range f.coins {
+		info := FaucetInfoCoin{
+			Denom:  c.Denom,
+			Amount: c.Amount.String(),
+		}
+
+		maxAmount, hasMax := f.coinsMax[c.Denom]
+		if hasMax && maxAmount > 0 {
+			info.MaxAmount = strconv.FormatUint(maxAmount, 10)
+		}
+
+		if m, ok := f.denomMetadata[c.Denom]; ok {
+			exponent := displayExponent(m)
+			info.DisplayDenom = m.Display
+			info.DisplayAmount = displayAmount(info.Amount, exponent)
+			info.Symbol = m.Symbol
+
+			if info.MaxAmount != "" {
+				info.DisplayMaxAmount = displayAmount(info.MaxAmount, exponent)
+			}
+		}
+
+		coins = append(coins, info)
+	}
+
+	return coins
+}
+
+// displayExponent returns the exponent of the display unit of a denom.
+func displayExponent(m genesis.DenomMetadata) uint32 {
+	for _, u := range m.DenomUnits {
+		if u.Denom == m.Display {
+			return u.Exponent
+		}
+	}
+
+	return 0
+}
+
+// displayAmount returns an integer amount of a base denom in a unit with an exponent,
+// e.g. the amount 1500000 is 1.5 in a unit with exponent 6.
+func displayAmount(amount string, exponent uint32) string {
+	if exponent == 0 {
+		return amount
+	}
+
+	e := int(exponent)
+	if len(amount) <= e {
+		amount = strings.Repeat("0", e-len(amount)+1) + amount
+	}
+
+	i := len(amount) - e
+	integer, fraction := amount[:i], strings.TrimRight(amount[i:], "0")
+	if fraction == "" {
+		return integer
+	}
+
+	return integer + "." + fraction
+}
+
 // coinsFromRequest determines tokens to transfer from transfer request.
 func (f Faucet) coinsFromRequest(req TransferRequest) (sdk.Coins, error) {
 	if len(req.Coins) == 0 {
@@ -0,0 +1,28 @@
+package cosmosfaucet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisplayAmount(t *testing.T) {
+	cases := []struct {
+		amount   string
+		exponent uint32
+		want     string
+	}{
+		{"1500000", 0, "1500000"},
+		{"1500000", 6, "1.5"},
+		{"10000000", 6, "10"},
+		{"1", 6, "0.000001"},
+		{"0", 6, "0"},
+		{"123456", 3, "123.456"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.amount, func(t *testing.T) {
+			require.Equal(t, tt.want, displayAmount(tt.amount, tt.exponent))
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
 )
 
 // generateOptions used to configure code generation.
@@ -18,6 +19,7 @@ type generateOptions struct {
 	jsOut               func(module.Module) string
 	jsIncludeThirdParty bool
 	tsClientRootPath    string
+	denomMetadata       []genesis.DenomMetadata
 
 	vuexOut      func(module.Module) string
 	vuexRootPath string
@@ -46,6 +48,14 @@ func WithTSClientGeneration(out ModulePathFunc, tsClientRootPath string) Option
 	}
 }
 
+// WithDenomMetadata adds the metadata of the chain denoms to the generated Typescript Client,
+// which is used to display the amounts of the coins in their display denom.
+func WithDenomMetadata(metadata []genesis.DenomMetadata) Option {
+	return func(o *generateOptions) {
+		o.denomMetadata = metadata
+	}
+}
+
 func WithVuexGeneration(includeThirdPartyModules bool, out ModulePathFunc, vuexRootPath string) Option {
 	return func(o *generateOptions) {
 		o.vuexOut = out
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
 	"github.com/ignite/cli/ignite/pkg/dirchange"
 	"github.com/ignite/cli/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/ignite/pkg/nodetime/programs/sta"
@@ -29,8 +30,9 @@ type tsGenerator struct {
 }
 
 type generatePayload struct {
-	Modules   []module.Module
-	PackageNS string
+	Modules       []module.Module
+	PackageNS     string
+	DenomMetadata []genesis.DenomMetadata
 }
 
 func newTSGenerator(g *generator) *tsGenerator {
@@ -45,8 +47,9 @@ func (g *generator) generateTS() error {
 
 	appModulePath := gomodulepath.ExtractAppPath(chainPath.RawPath)
 	data := generatePayload{
-		Modules:   g.appModules,
-		PackageNS: strings.ReplaceAll(appModulePath, "/", "-"),
+		Modules:       g.appModules,
+		PackageNS:     strings.ReplaceAll(appModulePath, "/", "-"),
+		DenomMetadata: g.o.denomMetadata,
 	}
 
 	// Third party modules are always required to generate the root
@@ -2,6 +2,7 @@ package cosmosgen
 
 import (
 	"embed"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
@@ -79,6 +80,10 @@ func (t templateWriter) Write(destDir, protoPath string, data interface{}) error
 			return i + 1
 		},
 		"replace": strings.ReplaceAll,
+		"jsonString": func(s string) string {
+			b, _ := json.Marshal(s)
+			return string(b)
+		},
 	}
 
 	// render and write the template.
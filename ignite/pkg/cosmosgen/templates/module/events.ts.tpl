@@ -0,0 +1,35 @@
+{{ range .Module.Events }}import { {{ .Name }} } from "./types/{{ resolveFile .FilePath }}";
+{{ end }}
+type EventType = { fromJSON(object: any): any };
+
+type TxEvent = {
+  type: string,
+  attributes: { key: string, value: string }[]
+};
+
+const eventTypes: { [type: string]: EventType } = {
+    {{ range .Module.Events }}"{{ .URI }}": {{ .Name }},
+    {{ end }}
+};
+
+// The attribute keys of the typed events are the proto field names
+// and the attribute values are JSON encoded.
+const snakeToCamel = (key: string) => key.replace(/_([a-z0-9])/g, (_, c) => c.toUpperCase());
+
+// decodeEvent decodes a typed event of the module from the events of a tx result,
+// undefined is returned when the event is not a typed event of the module.
+const decodeEvent = (event: TxEvent) => {
+  const eventType = eventTypes[event.type];
+  if (!eventType) {
+    return undefined;
+  }
+
+  const object: { [key: string]: any } = {};
+  for (const { key, value } of event.attributes) {
+    object[snakeToCamel(key)] = JSON.parse(value);
+  }
+
+  return eventType.fromJSON(object);
+};
+
+export { eventTypes, decodeEvent }
@@ -2,6 +2,7 @@
 import { Registry } from '@cosmjs/proto-signing'
 import { IgniteClient } from "./client";
 import { MissingWalletError } from "./helpers";
+import { tokens, getToken, toDisplayAmount } from "./tokens";
 {{ range .Modules }}import { Module as {{ camelCaseUpperSta .Pkg.Name }}, msgTypes as {{ camelCaseUpperSta .Pkg.Name }}MsgTypes } from './{{ .Pkg.Name }}'
 {{ end }}
 
@@ -17,5 +18,8 @@ const registry = new Registry([
 export {
     Client,
     registry,
-    MissingWalletError
+    MissingWalletError,
+    tokens,
+    getToken,
+    toDisplayAmount
 }
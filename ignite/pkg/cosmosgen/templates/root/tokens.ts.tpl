@@ -0,0 +1,67 @@
+// Generated by Ignite ignite.com/cli
+
+export interface DenomUnit {
+  denom: string
+  exponent: number
+  aliases: string[]
+}
+
+export interface Token {
+  base: string
+  display: string
+  name: string
+  symbol: string
+  description: string
+  uri: string
+  denomUnits: DenomUnit[]
+}
+
+export interface DisplayAmount {
+  amount: string
+  denom: string
+}
+
+export const tokens: Token[] = [
+{{- range .DenomMetadata }}
+  {
+    base: {{ jsonString .Base }},
+    display: {{ jsonString .Display }},
+    name: {{ jsonString .Name }},
+    symbol: {{ jsonString .Symbol }},
+    description: {{ jsonString .Description }},
+    uri: {{ jsonString .URI }},
+    denomUnits: [
+      {{- range .DenomUnits }}
+      { denom: {{ jsonString .Denom }}, exponent: {{ .Exponent }}, aliases: [{{ range $i, $alias := .Aliases }}{{ if (gt $i 0) }}, {{ end }}{{ jsonString $alias }}{{ end }}] },
+      {{- end }}
+    ],
+  },
+{{- end }}
+]
+
+export function getToken(denom: string): Token | undefined {
+  return tokens.find((token) => token.base === denom)
+}
+
+// toDisplayAmount converts an amount of a base denom to the display denom of its token,
+// e.g. 1500000uatom is 1.5atom. The amount is returned as it is when the token is unknown.
+export function toDisplayAmount(amount: string, denom: string): DisplayAmount {
+  const token = getToken(denom)
+  if (!token) {
+    return { amount, denom }
+  }
+
+  const unit = token.denomUnits.find((u) => u.denom === token.display)
+  if (!unit || unit.exponent === 0) {
+    return { amount, denom }
+  }
+
+  const padded = amount.padStart(unit.exponent + 1, "0")
+  const integer = padded.slice(0, padded.length - unit.exponent)
+  const fraction = padded.slice(padded.length - unit.exponent).replace(/0+$/, "")
+
+  return {
+    amount: fraction ? `${integer}.${fraction}` : integer,
+    denom: token.display,
+  }
+}
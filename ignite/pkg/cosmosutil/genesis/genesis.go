@@ -2,6 +2,8 @@ package genesis
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
 
 	"github.com/ignite/cli/ignite/pkg/jsonfile"
@@ -14,6 +16,7 @@ const (
 	fieldPathChainID    = "chain_id"
 	fieldPathAccounts   = "app_state.auth.accounts"
 	fieldPathGentxs     = "app_state.genutil.gen_txs"
+	fieldDenomMetadata  = "app_state.bank.denom_metadata"
 
 	FieldGenesisTime                 = "genesis_time"
 	FieldChainID                     = "chain_id"
@@ -24,6 +27,13 @@ const (
 	FieldConsensusRootHash           = "app_state.monitoringp.params.consumerConsensusState.root.hash"
 	FieldConsumerUnbondingPeriod     = "app_state.monitoringp.params.consumerUnbondingPeriod"
 	FieldConsumerRevisionHeight      = "app_state.monitoringp.params.consumerRevisionHeight"
+
+	FieldConsensusBlockMaxBytes        = "consensus_params.block.max_bytes"
+	FieldConsensusBlockMaxGas          = "consensus_params.block.max_gas"
+	FieldConsensusEvidenceMaxAgeBlocks = "consensus_params.evidence.max_age_num_blocks"
+	FieldConsensusEvidenceMaxAge       = "consensus_params.evidence.max_age_duration"
+	FieldConsensusEvidenceMaxBytes     = "consensus_params.evidence.max_bytes"
+	FieldConsensusValidatorPubKeyTypes = "consensus_params.validator.pub_key_types"
 )
 
 type (
@@ -35,6 +45,25 @@ type (
 		Address string `json:"address"`
 	}
 	gentxs []struct{}
+
+	// DenomMetadata represents the metadata of a denom in the bank genesis state
+	DenomMetadata struct {
+		Description string      `json:"description"`
+		DenomUnits  []DenomUnit `json:"denom_units"`
+		Base        string      `json:"base"`
+		Display     string      `json:"display"`
+		Name        string      `json:"name"`
+		Symbol      string      `json:"symbol"`
+		URI         string      `json:"uri"`
+		URIHash     string      `json:"uri_hash"`
+	}
+
+	// DenomUnit represents a unit of a denom with its exponent to the base denom
+	DenomUnit struct {
+		Denom    string   `json:"denom"`
+		Exponent uint32   `json:"exponent"`
+		Aliases  []string `json:"aliases"`
+	}
 )
 
 // FromPath parse genesis object from path
@@ -112,3 +141,60 @@ func (g *Genesis) GentxCount() (int, error) {
 	err := g.Field(fieldPathGentxs, &gentxs)
 	return len(gentxs), err
 }
+
+// DenomMetadata returns the denom metadata of the bank module from the genesis
+func (g *Genesis) DenomMetadata() ([]DenomMetadata, error) {
+	var metadata []DenomMetadata
+	err := g.Field(fieldDenomMetadata, &metadata)
+	if err == jsonfile.ErrFieldNotFound {
+		return nil, nil
+	}
+	return metadata, err
+}
+
+// AddDenomMetadata adds the metadata of a denom to the bank module genesis state.
+// The metadata already in the genesis is kept as is, and an error is returned
+// when the genesis already has metadata for the base denom.
+func (g *Genesis) AddDenomMetadata(metadata DenomMetadata) error {
+	if metadata.Base == "" {
+		return fmt.Errorf("the base denom of the metadata is required")
+	}
+
+	var list []json.RawMessage
+	if err := g.Field(fieldDenomMetadata, &list); err != nil && err != jsonfile.ErrFieldNotFound {
+		return err
+	}
+
+	for _, raw := range list {
+		var m struct {
+			Base string `json:"base"`
+		}
+		if err := json.Unmarshal(raw, &m); err != nil {
+			return err
+		}
+		if m.Base == metadata.Base {
+			return fmt.Errorf("genesis already has metadata for denom %s", metadata.Base)
+		}
+	}
+
+	if metadata.DenomUnits == nil {
+		metadata.DenomUnits = []DenomUnit{}
+	}
+	for i, u := range metadata.DenomUnits {
+		if u.Aliases == nil {
+			metadata.DenomUnits[i].Aliases = []string{}
+		}
+	}
+
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(append(list, raw))
+	if err != nil {
+		return err
+	}
+
+	return g.Update(jsonfile.WithKeyRawValue(fieldDenomMetadata, value))
+}
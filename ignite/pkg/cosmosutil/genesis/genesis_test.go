@@ -0,0 +1,97 @@
+package genesis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+
+func TestGenesis_AddDenomMetadata(t *testing.T) {
+	g := testGenesis(t)
+
+	metadata := DenomMetadata{
+		Description: "The token of Mars",
+		DenomUnits: []DenomUnit{
+			{Denom: "umars", Exponent: 0},
+			{Denom: "mars", Exponent: 6, Aliases: []string{"MARS"}},
+		},
+		Base:    "umars",
+		Display: "mars",
+		Symbol:  "MARS",
+	}
+	require.NoError(t, g.AddDenomMetadata(metadata))
+
+	// the metadata already in the genesis is kept
+	list, err := g.DenomMetadata()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, "stake", list[0].Base)
+	require.Equal(t, "umars", list[1].Base)
+	require.Equal(t, []string{}, list[1].DenomUnits[0].Aliases)
+	require.Equal(t, uint32(6), list[1].DenomUnits[1].Exponent)
+
+	require.Error(t, g.AddDenomMetadata(metadata))
+	require.Error(t, g.AddDenomMetadata(DenomMetadata{}))
+}
+
+func TestGenesis_ValidationErrorPaths(t *testing.T) {
+	g := testGenesis(t)
+
+	tests := []struct {
+		name    string
+		message string
+		want    []string
+	}{
+		{
+			name:    "address",
+			message: "Error: failed to validate bank genesis state: duplicate balance for address " + testAddress + ": exit status 1",
+			want: []string{
+				"app_state.bank.balances[0].address",
+				"app_state.bank.balances[1].address",
+			},
+		},
+		{
+			name:    "quoted value",
+			message: `Error: failed to validate staking genesis state: invalid bond denom "stake"`,
+			want:    []string{"app_state.staking.params.bond_denom"},
+		},
+		{
+			name:    "module",
+			message: "Error: failed to validate genutil genesis state: invalid gentx",
+			want:    []string{"app_state.genutil"},
+		},
+		{
+			name:    "unknown",
+			message: "Error: invalid genesis file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paths, err := g.ValidationErrorPaths(tt.message)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, paths)
+		})
+	}
+}
+
+func testGenesis(t *testing.T) *Genesis {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/genesis.json")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	g, err := FromPath(path)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, g.Close())
+	})
+
+	return g
+}
@@ -0,0 +1,83 @@
+package genesis
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/buger/jsonparser"
+
+	"github.com/ignite/cli/ignite/pkg/jsonfile"
+)
+
+const fieldAppState = "app_state"
+
+var (
+	// reValidationModule matches the module in the errors returned by the validate-genesis command.
+	reValidationModule = regexp.MustCompile(`failed to validate (\w+) genesis state`)
+
+	// reValidationQuoted matches the quoted values in the validation errors.
+	reValidationQuoted = regexp.MustCompile(`["'](\S+?)["']`)
+
+	// reValidationAddress matches the bech32 addresses in the validation errors.
+	reValidationAddress = regexp.MustCompile(`\b[a-z]+1[02-9ac-hj-np-z]{38,}\b`)
+)
+
+// ValidationErrorPaths returns the JSON paths of the genesis values that are the cause
+// of an error returned by the validate-genesis command of a chain.
+// The paths are found by looking for the addresses and quoted values of the error message
+// in the genesis state of the module that failed the validation. When none of the values
+// are found the path of the module's genesis state is returned.
+func (g *Genesis) ValidationErrorPaths(message string) ([]string, error) {
+	path := fieldAppState
+	if m := reValidationModule.FindStringSubmatch(message); m != nil {
+		path += "." + m[1]
+	}
+
+	values := make(map[string]bool)
+	for _, m := range reValidationQuoted.FindAllStringSubmatch(message, -1) {
+		values[m[1]] = true
+	}
+	for _, addr := range reValidationAddress.FindAllString(message, -1) {
+		values[addr] = true
+	}
+
+	state, err := g.RawField(path)
+	if err == jsonfile.ErrFieldNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	if len(values) > 0 {
+		findValuePaths(state, jsonparser.Object, path, values, &paths)
+	}
+
+	if len(paths) == 0 && path != fieldAppState {
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// findValuePaths appends to paths the JSON path of the string values of data that are in values.
+func findValuePaths(data []byte, dataType jsonparser.ValueType, path string, values map[string]bool, paths *[]string) {
+	switch dataType {
+	case jsonparser.Object:
+		_ = jsonparser.ObjectEach(data, func(key, value []byte, dataType jsonparser.ValueType, _ int) error {
+			findValuePaths(value, dataType, path+"."+string(key), values, paths)
+			return nil
+		})
+	case jsonparser.Array:
+		i := 0
+		_, _ = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, _ int, _ error) {
+			findValuePaths(value, dataType, path+"["+strconv.Itoa(i)+"]", values, paths)
+			i++
+		})
+	case jsonparser.String:
+		if values[strings.TrimSpace(string(data))] {
+			*paths = append(*paths, path)
+		}
+	}
+}
@@ -92,32 +92,54 @@ func (e Event) Text() string {
 
 // Bus is a send/receive event bus.
 type (
-	Bus struct {
-		evchan chan Event
-		buswg  *sync.WaitGroup
-	}
+	Bus = TypedBus[Event]
 
-	BusOption func(*Bus)
+	BusOption = TypedBusOption[Event]
 )
 
 // WithWaitGroup sets wait group which is blocked if events bus is not empty.
 func WithWaitGroup(wg *sync.WaitGroup) BusOption {
-	return func(bus *Bus) {
-		bus.buswg = wg
-	}
+	return WithTypedWaitGroup[Event](wg)
 }
 
 // WithCustomBufferSize configures buffer size of underlying bus channel
 func WithCustomBufferSize(size int) BusOption {
-	return func(bus *Bus) {
-		bus.evchan = make(chan Event, size)
-	}
+	return WithTypedBufferSize[Event](size)
 }
 
 // NewBus creates a new event bus to send/receive events.
 func NewBus(options ...BusOption) Bus {
-	bus := Bus{
-		evchan: make(chan Event),
+	return NewTypedBus(options...)
+}
+
+// TypedBus is a send/receive event bus for events of type T.
+type (
+	TypedBus[T any] struct {
+		evchan chan T
+		buswg  *sync.WaitGroup
+	}
+
+	TypedBusOption[T any] func(*TypedBus[T])
+)
+
+// WithTypedWaitGroup sets wait group which is blocked if a typed events bus is not empty.
+func WithTypedWaitGroup[T any](wg *sync.WaitGroup) TypedBusOption[T] {
+	return func(bus *TypedBus[T]) {
+		bus.buswg = wg
+	}
+}
+
+// WithTypedBufferSize configures buffer size of underlying typed bus channel
+func WithTypedBufferSize[T any](size int) TypedBusOption[T] {
+	return func(bus *TypedBus[T]) {
+		bus.evchan = make(chan T, size)
+	}
+}
+
+// NewTypedBus creates a new event bus to send/receive events of type T.
+func NewTypedBus[T any](options ...TypedBusOption[T]) TypedBus[T] {
+	bus := TypedBus[T]{
+		evchan: make(chan T),
 	}
 
 	for _, apply := range options {
@@ -128,7 +150,7 @@ func NewBus(options ...BusOption) Bus {
 }
 
 // Send sends a new event to bus.
-func (b Bus) Send(e Event) {
+func (b TypedBus[T]) Send(e T) {
 	if b.evchan == nil {
 		return
 	}
@@ -138,13 +160,13 @@ func (b Bus) Send(e Event) {
 	b.evchan <- e
 }
 
-// Events returns go channel with Event accessible only for read.
-func (b *Bus) Events() <-chan Event {
+// Events returns go channel with events accessible only for read.
+func (b *TypedBus[T]) Events() <-chan T {
 	return b.evchan
 }
 
 // Shutdown shutdowns event bus.
-func (b Bus) Shutdown() {
+func (b TypedBus[T]) Shutdown() {
 	if b.evchan == nil {
 		return
 	}
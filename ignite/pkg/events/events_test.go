@@ -1,7 +1,10 @@
 package events_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gookit/color"
 	"github.com/stretchr/testify/require"
@@ -200,3 +203,45 @@ func TestNewBus(t *testing.T) {
 		})
 	}
 }
+
+type testError struct {
+	Code int `json:"code"`
+}
+
+func (e testError) Error() string {
+	return "test error"
+}
+
+func (e testError) MarshalJSON() ([]byte, error) {
+	return []byte(`{"code":1}`), nil
+}
+
+func TestNewErrorInfo(t *testing.T) {
+	require.Nil(t, events.NewErrorInfo(nil))
+
+	info := events.NewErrorInfo(errors.New("failed"))
+	require.Equal(t, "errorString", info.Class)
+	require.Equal(t, "failed", info.Message)
+	require.Nil(t, info.Fields)
+
+	info = events.NewErrorInfo(fmt.Errorf("wrapped: %w", testError{Code: 1}))
+	require.Equal(t, "testError", info.Class)
+	require.Equal(t, "wrapped: test error", info.Message)
+	require.JSONEq(t, `{"code":1}`, string(info.Fields))
+}
+
+func TestLifecycleBus(t *testing.T) {
+	bus := events.NewLifecycleBus()
+	defer bus.Shutdown()
+
+	start := time.Now()
+	event := events.NewLifecycle(
+		events.PhaseStart,
+		events.PhaseSucceeded,
+		events.Addresses(map[string]string{"rpc": "http://0.0.0.0:26657"}),
+		events.Elapsed(start),
+	)
+
+	go bus.Send(event)
+	require.Equal(t, event, <-bus.Events())
+}
@@ -0,0 +1,158 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"time"
+)
+
+// Phase is a step in the lifecycle of a command.
+type Phase string
+
+const (
+	PhaseBuild    Phase = "build"
+	PhaseInit     Phase = "init"
+	PhaseRestore  Phase = "restore"
+	PhaseStart    Phase = "start"
+	PhaseExport   Phase = "export"
+	PhaseScaffold Phase = "scaffold"
+)
+
+// PhaseStatus shows if a lifecycle phase started, succeeded or failed.
+type PhaseStatus string
+
+const (
+	PhaseStarted   PhaseStatus = "started"
+	PhaseSucceeded PhaseStatus = "succeeded"
+	PhaseFailed    PhaseStatus = "failed"
+)
+
+type (
+	// LifecycleEvent is a machine-readable event that describes a lifecycle phase of a command.
+	LifecycleEvent struct {
+		// Phase of the lifecycle.
+		Phase Phase `json:"phase"`
+
+		// Status of the phase.
+		Status PhaseStatus `json:"status"`
+
+		// Message is an optional description of the event.
+		Message string `json:"message,omitempty"`
+
+		// Addresses holds the addresses of the started services by name.
+		Addresses map[string]string `json:"addresses,omitempty"`
+
+		// Error describes the error when the phase failed.
+		Error *ErrorInfo `json:"error,omitempty"`
+
+		// Time is the time when the event happened.
+		Time time.Time `json:"time"`
+
+		// DurationMS is the time in milliseconds that the phase took to succeed or fail.
+		DurationMS int64 `json:"duration_ms,omitempty"`
+	}
+
+	// ErrorInfo describes an error of a failed lifecycle phase.
+	ErrorInfo struct {
+		// Class is the type name of the error.
+		Class string `json:"class"`
+
+		// Message is the error text.
+		Message string `json:"message"`
+
+		// Fields contains the error fields when the error can be serialized to JSON.
+		Fields json.RawMessage `json:"fields,omitempty"`
+	}
+
+	// LifecycleOption configures lifecycle events.
+	LifecycleOption func(*LifecycleEvent)
+
+	// LifecycleBus is a send/receive event bus for lifecycle events.
+	LifecycleBus = TypedBus[LifecycleEvent]
+)
+
+// NewLifecycleBus creates a new event bus to send/receive lifecycle events.
+func NewLifecycleBus(options ...TypedBusOption[LifecycleEvent]) LifecycleBus {
+	return NewTypedBus(options...)
+}
+
+// Message sets the description of a lifecycle event.
+func Message(message string) LifecycleOption {
+	return func(e *LifecycleEvent) {
+		e.Message = message
+	}
+}
+
+// Addresses sets the addresses of the services started during a lifecycle phase.
+func Addresses(addresses map[string]string) LifecycleOption {
+	return func(e *LifecycleEvent) {
+		e.Addresses = addresses
+	}
+}
+
+// Elapsed sets the duration of a lifecycle phase that started at the given time.
+func Elapsed(start time.Time) LifecycleOption {
+	return func(e *LifecycleEvent) {
+		e.DurationMS = e.Time.Sub(start).Milliseconds()
+	}
+}
+
+// Failure sets the error of a failed lifecycle phase.
+func Failure(err error) LifecycleOption {
+	return func(e *LifecycleEvent) {
+		e.Error = NewErrorInfo(err)
+	}
+}
+
+// NewLifecycle creates a new lifecycle event.
+func NewLifecycle(phase Phase, status PhaseStatus, options ...LifecycleOption) LifecycleEvent {
+	e := LifecycleEvent{
+		Phase:  phase,
+		Status: status,
+		Time:   time.Now().UTC(),
+	}
+
+	for _, apply := range options {
+		apply(&e)
+	}
+
+	return e
+}
+
+// NewErrorInfo creates the description of an error.
+// When an error in the chain implements json.Marshaler its class and fields
+// are used instead of the ones of the outermost error.
+func NewErrorInfo(err error) *ErrorInfo {
+	if err == nil {
+		return nil
+	}
+
+	info := &ErrorInfo{
+		Class:   errorClass(err),
+		Message: err.Error(),
+	}
+
+	var m json.Marshaler
+	if errors.As(err, &m) {
+		if fields, err := m.MarshalJSON(); err == nil {
+			info.Class = errorClass(m)
+			info.Fields = fields
+		}
+	}
+
+	return info
+}
+
+func errorClass(v interface{}) string {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Name() == "" {
+		return "error"
+	}
+
+	return t.Name()
+}
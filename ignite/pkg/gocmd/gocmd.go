@@ -1,6 +1,7 @@
 package gocmd
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -28,18 +29,28 @@ const (
 
 	// CommandModVerify represents go mod "verify" command.
 	CommandModVerify = "verify"
+
+	// CommandEnv represents go "env" command.
+	CommandEnv = "env"
 )
 
 const (
 	FlagMod              = "-mod"
 	FlagModValueReadOnly = "readonly"
 	FlagLdflags          = "-ldflags"
+	FlagGcflags          = "-gcflags"
+	FlagAsmflags         = "-asmflags"
+	FlagTags             = "-tags"
+	FlagTrimpath         = "-trimpath"
+	FlagBuildvcs         = "-buildvcs"
 	FlagOut              = "-o"
 )
 
 const (
-	EnvGOOS   = "GOOS"
-	EnvGOARCH = "GOARCH"
+	EnvGOOS       = "GOOS"
+	EnvGOARCH     = "GOARCH"
+	EnvCGOEnabled = "CGO_ENABLED"
+	EnvGOVERSION  = "GOVERSION"
 )
 
 // Name returns the name of Go binary to use.
@@ -61,6 +72,15 @@ func ModVerify(ctx context.Context, path string, options ...exec.Option) error {
 	return exec.Exec(ctx, []string{Name(), CommandMod, CommandModVerify}, append(options, exec.StepOption(step.Workdir(path)))...)
 }
 
+// Env runs go env and returns the value of a Go environment variable.
+func Env(ctx context.Context, name string, options ...exec.Option) (string, error) {
+	var b bytes.Buffer
+	if err := exec.Exec(ctx, []string{Name(), CommandEnv, name}, append(options, exec.StepOption(step.Stdout(&b)))...); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
 // BuildPath runs go install on cmd folder with options.
 func BuildPath(ctx context.Context, output, binary, path string, flags []string, options ...exec.Option) error {
 	binaryOutput, err := binaryPath(output, binary)
@@ -105,6 +125,11 @@ func Ldflags(flags ...string) string {
 	return strings.Join(flags, " ")
 }
 
+// Tags returns a combined build tags set from tags.
+func Tags(tags ...string) string {
+	return strings.Join(tags, ",")
+}
+
 // BuildTarget builds a GOOS:GOARCH pair.
 func BuildTarget(goos, goarch string) string {
 	return fmt.Sprintf("%s:%s", goos, goarch)
@@ -1,6 +1,7 @@
 package gomodule
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -10,6 +11,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"golang.org/x/mod/modfile"
 	"golang.org/x/mod/module"
@@ -134,3 +136,109 @@ func LocatePath(ctx context.Context, cacheStorage cache.Storage, src string, pkg
 
 	return "", fmt.Errorf("module %q not found", pkg.Path)
 }
+
+// Module is a module of the build list of a main module.
+type Module struct {
+	Path     string
+	Version  string
+	Main     bool
+	Indirect bool
+
+	// Replace is the module that replaces this module.
+	Replace *Module
+}
+
+// Resolved returns the module version that is used in the build,
+// which is the replacement module when the module is replaced.
+func (m Module) Resolved() module.Version {
+	if m.Replace != nil {
+		return module.Version{Path: m.Replace.Path, Version: m.Replace.Version}
+	}
+
+	return module.Version{Path: m.Path, Version: m.Version}
+}
+
+// BuildList returns the main module and the modules of its build list, as resolved by "go list -m all".
+func BuildList(ctx context.Context, path string) ([]Module, error) {
+	out := &bytes.Buffer{}
+
+	if err := cmdrunner.
+		New().
+		Run(ctx, step.New(
+			step.Exec("go", "list", "-m", "-json", "all"),
+			step.Workdir(path),
+			step.Stdout(out),
+		)); err != nil {
+		return nil, err
+	}
+
+	var modules []Module
+
+	d := json.NewDecoder(out)
+	for {
+		var m Module
+		if err := d.Decode(&m); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		modules = append(modules, m)
+	}
+
+	return modules, nil
+}
+
+// Requirement is an edge of the module requirement graph.
+type Requirement struct {
+	Module   module.Version
+	Requires module.Version
+}
+
+// Graph returns the module requirement graph of the main module, as printed by "go mod graph".
+func Graph(ctx context.Context, path string) ([]Requirement, error) {
+	out := &bytes.Buffer{}
+
+	if err := cmdrunner.
+		New().
+		Run(ctx, step.New(
+			step.Exec("go", "mod", "graph"),
+			step.Workdir(path),
+			step.Stdout(out),
+		)); err != nil {
+		return nil, err
+	}
+
+	return ParseGraph(out)
+}
+
+// ParseGraph parses the module requirement graph printed by "go mod graph".
+func ParseGraph(r io.Reader) ([]Requirement, error) {
+	var requirements []Requirement
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid module graph line %q", s.Text())
+		}
+
+		requirements = append(requirements, Requirement{
+			Module:   parseModuleVersion(fields[0]),
+			Requires: parseModuleVersion(fields[1]),
+		})
+	}
+
+	return requirements, s.Err()
+}
+
+// parseModuleVersion parses a module version with the path@version format.
+// The version of the main module is empty.
+func parseModuleVersion(s string) module.Version {
+	path, version, _ := strings.Cut(s, "@")
+	return module.Version{Path: path, Version: version}
+}
@@ -0,0 +1,213 @@
+// Package jsondiff compares JSON documents without decoding them into maps,
+// so large documents like genesis files can be compared.
+package jsondiff
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/buger/jsonparser"
+)
+
+// RootPath is the path of the root value of a document.
+const RootPath = "$"
+
+// Kind is the kind of a change.
+type Kind string
+
+const (
+	// KindAdded is a value that is only in the second document.
+	KindAdded Kind = "added"
+
+	// KindRemoved is a value that is only in the first document.
+	KindRemoved Kind = "removed"
+
+	// KindChanged is a value that is different in both documents.
+	KindChanged Kind = "changed"
+)
+
+// Change is a difference between two documents.
+type Change struct {
+	// Path is the path of the value, e.g. app_state.bank.balances[0].address.
+	Path string
+
+	// Kind is the kind of the change.
+	Kind Kind
+
+	// Old is the JSON encoded value in the first document.
+	Old []byte
+
+	// New is the JSON encoded value in the second document.
+	New []byte
+}
+
+type value struct {
+	data     []byte
+	dataType jsonparser.ValueType
+}
+
+// Diff returns the changes between two JSON documents.
+// The values of objects are compared by key and the values of arrays by index.
+func Diff(a, b []byte) ([]Change, error) {
+	av, err := root(a)
+	if err != nil {
+		return nil, err
+	}
+
+	bv, err := root(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	err = diff("", av, bv, &changes)
+	return changes, err
+}
+
+func root(data []byte) (value, error) {
+	data, dataType, _, err := jsonparser.Get(data)
+	return value{data, dataType}, err
+}
+
+func diff(path string, a, b value, changes *[]Change) error {
+	if a.dataType != b.dataType {
+		*changes = append(*changes, Change{
+			Path: pathOrRoot(path),
+			Kind: KindChanged,
+			Old:  a.raw(),
+			New:  b.raw(),
+		})
+		return nil
+	}
+
+	switch a.dataType {
+	case jsonparser.Object:
+		return diffObjects(path, a, b, changes)
+	case jsonparser.Array:
+		return diffArrays(path, a, b, changes)
+	}
+
+	if !bytes.Equal(a.data, b.data) {
+		*changes = append(*changes, Change{
+			Path: pathOrRoot(path),
+			Kind: KindChanged,
+			Old:  a.raw(),
+			New:  b.raw(),
+		})
+	}
+
+	return nil
+}
+
+func diffObjects(path string, a, b value, changes *[]Change) error {
+	aKeys, aValues, err := objectValues(a.data)
+	if err != nil {
+		return err
+	}
+
+	bKeys, bValues, err := objectValues(b.data)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range aKeys {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+
+		bv, ok := bValues[key]
+		if !ok {
+			*changes = append(*changes, Change{Path: keyPath, Kind: KindRemoved, Old: aValues[key].raw()})
+			continue
+		}
+
+		if err := diff(keyPath, aValues[key], bv, changes); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range bKeys {
+		if _, ok := aValues[key]; ok {
+			continue
+		}
+
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+
+		*changes = append(*changes, Change{Path: keyPath, Kind: KindAdded, New: bValues[key].raw()})
+	}
+
+	return nil
+}
+
+func diffArrays(path string, a, b value, changes *[]Change) error {
+	aValues, err := arrayValues(a.data)
+	if err != nil {
+		return err
+	}
+
+	bValues, err := arrayValues(b.data)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(aValues) || i < len(bValues); i++ {
+		indexPath := path + "[" + strconv.Itoa(i) + "]"
+
+		switch {
+		case i >= len(bValues):
+			*changes = append(*changes, Change{Path: indexPath, Kind: KindRemoved, Old: aValues[i].raw()})
+		case i >= len(aValues):
+			*changes = append(*changes, Change{Path: indexPath, Kind: KindAdded, New: bValues[i].raw()})
+		default:
+			if err := diff(indexPath, aValues[i], bValues[i], changes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// objectValues returns the keys of an object in order and its values by key.
+func objectValues(data []byte) (keys []string, values map[string]value, err error) {
+	values = make(map[string]value)
+	err = jsonparser.ObjectEach(data, func(key, data []byte, dataType jsonparser.ValueType, _ int) error {
+		k := string(key)
+		if _, ok := values[k]; !ok {
+			keys = append(keys, k)
+		}
+		values[k] = value{data, dataType}
+		return nil
+	})
+
+	return keys, values, err
+}
+
+func arrayValues(data []byte) (values []value, err error) {
+	_, err = jsonparser.ArrayEach(data, func(data []byte, dataType jsonparser.ValueType, _ int, _ error) {
+		values = append(values, value{data, dataType})
+	})
+
+	return values, err
+}
+
+// raw returns the JSON encoded value.
+func (v value) raw() []byte {
+	if v.dataType == jsonparser.String {
+		return append(append([]byte{'"'}, v.data...), '"')
+	}
+
+	return v.data
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return RootPath
+	}
+
+	return path
+}
@@ -0,0 +1,75 @@
+package jsondiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want []Change
+		err  bool
+	}{
+		{
+			name: "equal documents",
+			a:    `{"chain_id": "mars-1", "app_state": {"bank": {"balances": []}}}`,
+			b:    `{"app_state":{"bank":{"balances":[]}},"chain_id":"mars-1"}`,
+		},
+		{
+			name: "changed values",
+			a:    `{"chain_id": "mars-1", "initial_height": "1", "app_state": {"mint": {"inflation": 0.13}}}`,
+			b:    `{"chain_id": "mars-2", "initial_height": 1, "app_state": {"mint": {"inflation": 0.2}}}`,
+			want: []Change{
+				{Path: "chain_id", Kind: KindChanged, Old: []byte(`"mars-1"`), New: []byte(`"mars-2"`)},
+				{Path: "initial_height", Kind: KindChanged, Old: []byte(`"1"`), New: []byte(`1`)},
+				{Path: "app_state.mint.inflation", Kind: KindChanged, Old: []byte(`0.13`), New: []byte(`0.2`)},
+			},
+		},
+		{
+			name: "added and removed keys",
+			a:    `{"chain_id": "mars-1", "app_state": {"crisis": {}}}`,
+			b:    `{"chain_id": "mars-1", "app_state": {"bank": {"send_enabled": true}}}`,
+			want: []Change{
+				{Path: "app_state.crisis", Kind: KindRemoved, Old: []byte(`{}`)},
+				{Path: "app_state.bank", Kind: KindAdded, New: []byte(`{"send_enabled": true}`)},
+			},
+		},
+		{
+			name: "array elements",
+			a:    `{"balances": [{"address": "a", "coins": []}, {"address": "b"}]}`,
+			b:    `{"balances": [{"address": "c", "coins": []}, {"address": "b"}, {"address": "d"}]}`,
+			want: []Change{
+				{Path: "balances[0].address", Kind: KindChanged, Old: []byte(`"a"`), New: []byte(`"c"`)},
+				{Path: "balances[2]", Kind: KindAdded, New: []byte(`{"address": "d"}`)},
+			},
+		},
+		{
+			name: "changed root",
+			a:    `[1]`,
+			b:    `{}`,
+			want: []Change{
+				{Path: RootPath, Kind: KindChanged, Old: []byte(`[1]`), New: []byte(`{}`)},
+			},
+		},
+		{
+			name: "invalid document",
+			a:    `{"chain_id": "mars-1"}`,
+			b:    `{"chain_id": `,
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := Diff([]byte(tt.a), []byte(tt.b))
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, changes)
+		})
+	}
+}
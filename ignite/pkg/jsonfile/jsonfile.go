@@ -1,7 +1,6 @@
 package jsonfile
 
 import (
-	"bufio"
 	"bytes"
 	"context"
 	"crypto/sha256"
@@ -143,6 +142,7 @@ func FromURL(ctx context.Context, url, destPath, tarballFileName string) (*JSONF
 }
 
 // Bytes returns the jsonfile byte array.
+// The file is read without decoding it, so large files can be read.
 func (f *JSONFile) Bytes() ([]byte, error) {
 	file := f.cache
 	if file != nil {
@@ -151,11 +151,8 @@ func (f *JSONFile) Bytes() ([]byte, error) {
 	if err := f.Reset(); err != nil {
 		return nil, err
 	}
-	scanner := bufio.NewScanner(f.file)
-	for scanner.Scan() {
-		file = append(file, scanner.Bytes()...)
-	}
-	if err := scanner.Err(); err != nil {
+	file, err := io.ReadAll(f.file)
+	if err != nil {
 		return nil, err
 	}
 	f.cache = file
@@ -164,13 +161,14 @@ func (f *JSONFile) Bytes() ([]byte, error) {
 
 // Field return the param by key and the position into byte slice from the file reader.
 // Key can be a path to a nested parameter eg: app_state.staking.accounts
+// or to an element of an array eg: app_state.bank.balances[0].address
 func (f *JSONFile) Field(key string, param interface{}) error {
 	file, err := f.Bytes()
 	if err != nil {
 		return err
 	}
 
-	value, dataType, _, err := jsonparser.Get(file, strings.Split(key, keySeparator)...)
+	value, dataType, _, err := jsonparser.Get(file, Keys(key)...)
 	if err == jsonparser.KeyPathNotFoundError {
 		return ErrFieldNotFound
 	} else if err != nil {
@@ -200,6 +198,51 @@ func (f *JSONFile) Field(key string, param interface{}) error {
 	return nil
 }
 
+// RawField returns the JSON encoded value of a param by key.
+func (f *JSONFile) RawField(key string) ([]byte, error) {
+	file, err := f.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	value, dataType, _, err := jsonparser.Get(file, Keys(key)...)
+	if err == jsonparser.KeyPathNotFoundError {
+		return nil, ErrFieldNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	// the string values are returned without quotes
+	if dataType == jsonparser.String {
+		value = append(append([]byte{'"'}, value...), '"')
+	}
+
+	return value, nil
+}
+
+// Keys returns the keys of a path to a nested parameter, the array elements are
+// selected by index eg: app_state.bank.balances[0].address. The $ root is optional.
+func Keys(path string) []string {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), keySeparator)
+
+	var keys []string
+	for _, key := range strings.Split(path, keySeparator) {
+		// split the indexes of the key eg: balances[0][1]
+		for key != "" {
+			i := strings.Index(key[1:], "[")
+			if i < 0 {
+				keys = append(keys, key)
+				break
+			}
+
+			keys = append(keys, key[:i+1])
+			key = key[i+1:]
+		}
+	}
+
+	return keys
+}
+
 // WithKeyValue update a file value object by key
 func WithKeyValue(key string, value string) UpdateFileOption {
 	return func(update map[string][]byte) {
@@ -227,15 +270,28 @@ func WithKeyValueUint(key string, value uint64) UpdateFileOption {
 	return WithKeyValueInt(key, int64(value))
 }
 
+// WithKeyRawValue update a file value object by key with a JSON encoded value
+func WithKeyRawValue(key string, value []byte) UpdateFileOption {
+	return func(update map[string][]byte) {
+		update[key] = value
+	}
+}
+
 // Update updates the file with the new parameters by key
 func (f *JSONFile) Update(opts ...UpdateFileOption) error {
 	for _, opt := range opts {
 		opt(f.updates)
 	}
-	if err := f.Reset(); err != nil {
+
+	// the whole file is updated at once because the
+	// keys can't be updated in chunks of the file
+	f.cache = nil
+	file, err := f.Bytes()
+	if err != nil {
 		return err
 	}
-	_, err := io.Copy(f, f.file)
+
+	_, err = f.Write(file)
 	return err
 }
 
@@ -244,7 +300,7 @@ func (f *JSONFile) Write(p []byte) (int, error) {
 	var err error
 	length := len(p)
 	for key, value := range f.updates {
-		p, err = jsonparser.Set(p, value, strings.Split(key, keySeparator)...)
+		p, err = jsonparser.Set(p, value, Keys(key)...)
 		if err != nil {
 			return 0, err
 		}
@@ -68,6 +68,12 @@ func TestJSONFile_Field(t *testing.T) {
 			key:      "app_state.bank.balances.[0].coins",
 			want:     sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(95000000))},
 		},
+		{
+			name:     "get array element parameter",
+			filepath: "testdata/jsonfile.json",
+			key:      "$.app_state.bank.balances[0].coins[0].denom",
+			want:     "stake",
+		},
 		{
 			name:     "get custom parameter",
 			filepath: "testdata/jsonfile.json",
@@ -114,6 +120,43 @@ func TestJSONFile_Field(t *testing.T) {
 	}
 }
 
+func TestJSONFile_RawField(t *testing.T) {
+	f, err := FromPath("testdata/jsonfile.json")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, f.Close())
+	})
+
+	value, err := f.RawField("consensus_params.block.max_bytes")
+	require.NoError(t, err)
+	require.Equal(t, `"22020096"`, string(value))
+
+	value, err = f.RawField("consensus_params.block.best_blocks[1]")
+	require.NoError(t, err)
+	require.Equal(t, "20", string(value))
+
+	_, err = f.RawField("invalid.field.path")
+	require.ErrorIs(t, err, ErrFieldNotFound)
+}
+
+func TestKeys(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "chain_id", want: []string{"chain_id"}},
+		{path: "$.app_state.auth", want: []string{"app_state", "auth"}},
+		{path: "app_state.bank.balances.[0].coins", want: []string{"app_state", "bank", "balances", "[0]", "coins"}},
+		{path: "app_state.bank.balances[0].coins[1]", want: []string{"app_state", "bank", "balances", "[0]", "coins", "[1]"}},
+		{path: "validators[0][1]", want: []string{"validators", "[0]", "[1]"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			require.Equal(t, tt.want, Keys(tt.path))
+		})
+	}
+}
+
 func TestJSONFile_Update(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -179,6 +222,16 @@ func TestJSONFile_Update(t *testing.T) {
 				),
 			},
 		},
+		{
+			name:     "update array element field",
+			filepath: "testdata/jsonfile.json",
+			opts: []UpdateFileOption{
+				WithKeyRawValue(
+					"app_state.bank.balances[0].coins[0].amount",
+					[]byte(`"1000"`),
+				),
+			},
+		},
 		{
 			name:     "add non-existing field",
 			filepath: "testdata/jsonfile.json",
@@ -0,0 +1,382 @@
+// Package jsonmigrate applies declarative migrations to JSON documents.
+//
+// A migration is an ordered list of operations, each operation targets the values
+// of the document that match a path. Paths use a subset of the JSONPath syntax:
+// object keys are separated by dots, array elements are selected by index and the
+// [*] wildcard selects every element of an array or every value of an object,
+// e.g. $.app_state.blog.postList[*].title.
+package jsonmigrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Operation types.
+const (
+	// OpSet sets the value of the matching paths, the missing object keys of the path are created.
+	OpSet = "set"
+
+	// OpDelete deletes the matching object keys.
+	OpDelete = "delete"
+
+	// OpRename renames the matching object keys.
+	OpRename = "rename"
+)
+
+// Operation is a transformation of the values that match a path.
+type Operation struct {
+	// Op is the type of the operation.
+	Op string `yaml:"op"`
+
+	// Path selects the values of the document.
+	Path string `yaml:"path"`
+
+	// Value is the value assigned by the set operation.
+	Value interface{} `yaml:"value"`
+
+	// To is the new name of the object key renamed by the rename operation.
+	To string `yaml:"to"`
+}
+
+// Parse parses a migration in YAML or JSON and validates its operations.
+func Parse(r io.Reader) ([]Operation, error) {
+	var ops []Operation
+	if err := yaml.NewDecoder(r).Decode(&ops); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	for i, op := range ops {
+		if err := op.validate(); err != nil {
+			return nil, fmt.Errorf("operation #%d: %w", i+1, err)
+		}
+
+		ops[i].Value = normalize(op.Value)
+	}
+
+	return ops, nil
+}
+
+func (o Operation) validate() error {
+	segments, err := parsePath(o.Path)
+	if err != nil {
+		return err
+	}
+
+	last := segments[len(segments)-1]
+
+	switch o.Op {
+	case OpSet:
+	case OpDelete, OpRename:
+		if last.kind != segmentKey {
+			return fmt.Errorf("%s: the path of a %s operation must end with an object key", o.Path, o.Op)
+		}
+
+		if o.Op == OpRename && o.To == "" {
+			return fmt.Errorf("%s: the new key name of the rename operation is missing", o.Path)
+		}
+	default:
+		return fmt.Errorf("unknown operation %q", o.Op)
+	}
+
+	return nil
+}
+
+// Apply applies the operations in order to a decoded JSON document.
+func Apply(doc interface{}, ops ...Operation) error {
+	for _, op := range ops {
+		if err := op.validate(); err != nil {
+			return err
+		}
+
+		segments, _ := parsePath(op.Path)
+
+		var fn func(parent interface{}, last segment) error
+		switch op.Op {
+		case OpSet:
+			fn = func(parent interface{}, last segment) error {
+				return set(parent, last, op.Value)
+			}
+		case OpDelete:
+			fn = func(parent interface{}, last segment) error {
+				delete(parent.(map[string]interface{}), last.key)
+				return nil
+			}
+		case OpRename:
+			fn = func(parent interface{}, last segment) error {
+				obj := parent.(map[string]interface{})
+				value, ok := obj[last.key]
+				if !ok {
+					return nil
+				}
+
+				if _, ok := obj[op.To]; ok {
+					return fmt.Errorf("%s: cannot rename to %q, the key already exists", op.Path, op.To)
+				}
+
+				delete(obj, last.key)
+				obj[op.To] = value
+				return nil
+			}
+		}
+
+		if err := walk(doc, segments, op.Op == OpSet, fn); err != nil {
+			return fmt.Errorf("%s %s: %w", op.Op, op.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// ApplyJSON applies the operations in order to a JSON document and returns the migrated document.
+// The numbers of the document are kept as they are to not lose precision.
+func ApplyJSON(data []byte, ops ...Operation) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	if err := Apply(doc, ops...); err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+type segmentKind int
+
+const (
+	segmentKey segmentKind = iota
+	segmentIndex
+	segmentWildcard
+)
+
+type segment struct {
+	kind  segmentKind
+	key   string
+	index int
+}
+
+// parsePath parses a path like $.a.b[0].c[*] into its segments, the $ root is optional.
+func parsePath(path string) ([]segment, error) {
+	p := strings.TrimPrefix(path, "$")
+	if p != "" && p[0] != '.' && p[0] != '[' {
+		p = "." + p
+	}
+
+	var segments []segment
+	for p != "" {
+		switch p[0] {
+		case '.':
+			p = p[1:]
+
+			end := strings.IndexAny(p, ".[")
+			if end == -1 {
+				end = len(p)
+			}
+
+			if end == 0 {
+				return nil, fmt.Errorf("%s: empty object key", path)
+			}
+
+			segments = append(segments, segment{kind: segmentKey, key: p[:end]})
+			p = p[end:]
+		case '[':
+			end := strings.IndexByte(p, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("%s: missing closing bracket", path)
+			}
+
+			selector := p[1:end]
+			p = p[end+1:]
+
+			if selector == "*" {
+				segments = append(segments, segment{kind: segmentWildcard})
+				continue
+			}
+
+			index, err := strconv.Atoi(selector)
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("%s: invalid array index %q", path, selector)
+			}
+
+			segments = append(segments, segment{kind: segmentIndex, index: index})
+		default:
+			return nil, fmt.Errorf("%s: unexpected character %q", path, p[0])
+		}
+	}
+
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("%q: the path must select a value of the document", path)
+	}
+
+	return segments, nil
+}
+
+// walk calls fn with each value that matches the path without its last segment.
+// The missing object keys are created when create is true, otherwise they are skipped.
+func walk(node interface{}, segments []segment, create bool, fn func(parent interface{}, last segment) error) error {
+	seg := segments[0]
+
+	if len(segments) == 1 {
+		if seg.kind == segmentKey {
+			if _, ok := node.(map[string]interface{}); !ok {
+				return fmt.Errorf("cannot select key %q of a non object value", seg.key)
+			}
+		}
+
+		return fn(node, seg)
+	}
+
+	next := segments[1:]
+
+	switch seg.kind {
+	case segmentWildcard:
+		switch v := node.(type) {
+		case []interface{}:
+			for _, child := range v {
+				if err := walk(child, next, create, fn); err != nil {
+					return err
+				}
+			}
+		case map[string]interface{}:
+			for _, key := range sortedKeys(v) {
+				if err := walk(v[key], next, create, fn); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("cannot select the elements of a non array value")
+		}
+
+		return nil
+
+	case segmentIndex:
+		arr, ok := node.([]interface{})
+		if !ok {
+			return fmt.Errorf("cannot select index %d of a non array value", seg.index)
+		}
+
+		if seg.index >= len(arr) {
+			if create {
+				return fmt.Errorf("index %d is out of range", seg.index)
+			}
+			return nil
+		}
+
+		return walk(arr[seg.index], next, create, fn)
+
+	default:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("cannot select key %q of a non object value", seg.key)
+		}
+
+		child, ok := obj[seg.key]
+		if !ok {
+			if !create {
+				return nil
+			}
+
+			child = make(map[string]interface{})
+			obj[seg.key] = child
+		}
+
+		return walk(child, next, create, fn)
+	}
+}
+
+// set assigns a copy of value to the elements of parent selected by the last segment.
+func set(parent interface{}, last segment, value interface{}) error {
+	switch last.kind {
+	case segmentKey:
+		parent.(map[string]interface{})[last.key] = clone(value)
+
+	case segmentIndex:
+		arr, ok := parent.([]interface{})
+		if !ok {
+			return fmt.Errorf("cannot select index %d of a non array value", last.index)
+		}
+
+		if last.index >= len(arr) {
+			return fmt.Errorf("index %d is out of range", last.index)
+		}
+
+		arr[last.index] = clone(value)
+
+	case segmentWildcard:
+		switch v := parent.(type) {
+		case []interface{}:
+			for i := range v {
+				v[i] = clone(value)
+			}
+		case map[string]interface{}:
+			for key := range v {
+				v[key] = clone(value)
+			}
+		default:
+			return fmt.Errorf("cannot select the elements of a non array value")
+		}
+	}
+
+	return nil
+}
+
+// normalize converts the objects decoded from YAML into JSON compatible objects.
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		obj := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			obj[fmt.Sprint(key)] = normalize(val)
+		}
+		return obj
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for i, val := range v {
+			arr[i] = normalize(val)
+		}
+		return arr
+	default:
+		return v
+	}
+}
+
+// clone returns a deep copy of a JSON compatible value so it's not shared between multiple parents.
+func clone(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		obj := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			obj[key] = clone(val)
+		}
+		return obj
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for i, val := range v {
+			arr[i] = clone(val)
+		}
+		return arr
+	default:
+		return v
+	}
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,196 @@
+package jsonmigrate_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/jsonmigrate"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []jsonmigrate.Operation
+		err     string
+	}{
+		{
+			name: "yaml",
+			content: `
+- op: set
+  path: $.app_state.blog.params
+  value:
+    max_posts: 10
+- op: rename
+  path: app_state.posts
+  to: blog
+- op: delete
+  path: $.app_state.blog.postList[*].legacy
+`,
+			want: []jsonmigrate.Operation{
+				{Op: "set", Path: "$.app_state.blog.params", Value: map[string]interface{}{"max_posts": 10}},
+				{Op: "rename", Path: "app_state.posts", To: "blog"},
+				{Op: "delete", Path: "$.app_state.blog.postList[*].legacy"},
+			},
+		},
+		{
+			name:    "json",
+			content: `[{"op": "set", "path": "$.a[0]", "value": ["x"]}]`,
+			want: []jsonmigrate.Operation{
+				{Op: "set", Path: "$.a[0]", Value: []interface{}{"x"}},
+			},
+		},
+		{
+			name: "empty",
+		},
+		{
+			name:    "unknown operation",
+			content: `[{"op": "copy", "path": "$.a"}]`,
+			err:     `operation #1: unknown operation "copy"`,
+		},
+		{
+			name:    "rename without new key",
+			content: `[{"op": "rename", "path": "$.a"}]`,
+			err:     "operation #1: $.a: the new key name of the rename operation is missing",
+		},
+		{
+			name:    "delete array element",
+			content: `[{"op": "delete", "path": "$.a[0]"}]`,
+			err:     "operation #1: $.a[0]: the path of a delete operation must end with an object key",
+		},
+		{
+			name:    "invalid index",
+			content: `[{"op": "set", "path": "$.a[x]"}]`,
+			err:     `operation #1: $.a[x]: invalid array index "x"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops, err := jsonmigrate.Parse(strings.NewReader(tt.content))
+
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, ops)
+		})
+	}
+}
+
+func TestApplyJSON(t *testing.T) {
+	doc := `{
+  "app_state": {
+    "blog": {
+      "postList": [
+        {"id": "0", "title": "a", "legacy": true},
+        {"id": "1", "title": "b", "legacy": false}
+      ]
+    },
+    "posts": {"count": "2"}
+  },
+  "initial_height": 1000000000000000000001
+}`
+
+	tests := []struct {
+		name string
+		ops  []jsonmigrate.Operation
+		want string
+		err  string
+	}{
+		{
+			name: "set a new field in every element",
+			ops: []jsonmigrate.Operation{
+				{Op: "set", Path: "$.app_state.blog.postList[*].priority", Value: "0"},
+			},
+			want: `{
+  "app_state": {
+    "blog": {
+      "postList": [
+        {"id": "0", "title": "a", "legacy": true, "priority": "0"},
+        {"id": "1", "title": "b", "legacy": false, "priority": "0"}
+      ]
+    },
+    "posts": {"count": "2"}
+  },
+  "initial_height": 1000000000000000000001
+}`,
+		},
+		{
+			name: "set creates the missing keys",
+			ops: []jsonmigrate.Operation{
+				{Op: "set", Path: "$.app_state.mars.params", Value: map[string]interface{}{"enabled": true}},
+				{Op: "set", Path: "$.app_state.blog.postList[1].title", Value: "c"},
+			},
+			want: `{
+  "app_state": {
+    "blog": {
+      "postList": [
+        {"id": "0", "title": "a", "legacy": true},
+        {"id": "1", "title": "c", "legacy": false}
+      ]
+    },
+    "mars": {"params": {"enabled": true}},
+    "posts": {"count": "2"}
+  },
+  "initial_height": 1000000000000000000001
+}`,
+		},
+		{
+			name: "delete and rename",
+			ops: []jsonmigrate.Operation{
+				{Op: "delete", Path: "$.app_state.blog.postList[*].legacy"},
+				{Op: "rename", Path: "$.app_state.posts", To: "stats"},
+				{Op: "delete", Path: "$.app_state.missing.key"},
+				{Op: "rename", Path: "$.app_state.missing", To: "other"},
+			},
+			want: `{
+  "app_state": {
+    "blog": {
+      "postList": [
+        {"id": "0", "title": "a"},
+        {"id": "1", "title": "b"}
+      ]
+    },
+    "stats": {"count": "2"}
+  },
+  "initial_height": 1000000000000000000001
+}`,
+		},
+		{
+			name: "rename to an existing key",
+			ops: []jsonmigrate.Operation{
+				{Op: "rename", Path: "$.app_state.posts", To: "blog"},
+			},
+			err: `rename $.app_state.posts: $.app_state.posts: cannot rename to "blog", the key already exists`,
+		},
+		{
+			name: "set out of range",
+			ops: []jsonmigrate.Operation{
+				{Op: "set", Path: "$.app_state.blog.postList[2].title", Value: "c"},
+			},
+			err: "set $.app_state.blog.postList[2].title: index 2 is out of range",
+		},
+		{
+			name: "select a key of an array",
+			ops: []jsonmigrate.Operation{
+				{Op: "set", Path: "$.app_state.blog.postList.title", Value: "c"},
+			},
+			err: `set $.app_state.blog.postList.title: cannot select key "title" of a non object value`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := jsonmigrate.ApplyJSON([]byte(doc), tt.ops...)
+
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Package nodelog parses, filters and renders the JSON logs of Cosmos SDK blockchain nodes.
+package nodelog
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/buger/jsonparser"
+)
+
+// Level is the level of a log entry.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// DefaultLevel is the level of the modules without a level in a filter.
+const DefaultLevel = LevelInfo
+
+// defaultModule is the module used in filters to set the level of the rest of the modules.
+const defaultModule = "*"
+
+// modulePrefix is the optional prefix of the modules in filters, e.g. module=x/mymodule:debug.
+const modulePrefix = "module="
+
+var levelNames = map[Level]string{
+	LevelDebug: "debug",
+	LevelInfo:  "info",
+	LevelWarn:  "warn",
+	LevelError: "error",
+}
+
+// ParseLevel parses the name of a log level.
+// The trace, fatal and panic levels of the node loggers are mapped to the closest level.
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(name) {
+	case "trace", "debug", "dbg":
+		return LevelDebug, nil
+	case "info", "inf", "":
+		return LevelInfo, nil
+	case "warn", "warning", "wrn":
+		return LevelWarn, nil
+	case "error", "err", "fatal", "panic":
+		return LevelError, nil
+	}
+
+	return 0, fmt.Errorf("invalid log level %q", name)
+}
+
+// String returns the name of the level.
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+// Field is a key value pair of a log entry.
+type Field struct {
+	Key, Value string
+}
+
+// Entry is a log entry of a node.
+type Entry struct {
+	Time    time.Time
+	Level   Level
+	Module  string
+	Message string
+	Fields  []Field
+}
+
+// Parse parses a JSON log line of a node.
+// Both the Tendermint and the zerolog JSON formats are supported.
+// False is returned when the line is not a JSON log entry.
+func Parse(line []byte) (e Entry, ok bool) {
+	if len(line) == 0 || line[0] != '{' {
+		return Entry{}, false
+	}
+
+	err := jsonparser.ObjectEach(line, func(key, value []byte, dataType jsonparser.ValueType, _ int) error {
+		v := string(value)
+		if dataType == jsonparser.String {
+			if s, err := jsonparser.ParseString(value); err == nil {
+				v = s
+			}
+		}
+
+		switch string(key) {
+		case "level":
+			level, err := ParseLevel(v)
+			if err != nil {
+				return err
+			}
+			e.Level = level
+		case "module":
+			e.Module = v
+		case "message", "_msg":
+			e.Message = v
+		case "time", "ts":
+			if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+				e.Time = t
+			}
+		default:
+			e.Fields = append(e.Fields, Field{Key: string(key), Value: v})
+		}
+
+		return nil
+	})
+
+	return e, err == nil
+}
+
+// Filter selects the log entries by the level of their module and by a pattern.
+type Filter struct {
+	levels map[string]Level
+	match  *regexp.Regexp
+}
+
+// FilterOption configures a filter.
+type FilterOption func(*Filter) error
+
+// FilterLevels sets the levels of the modules from a comma separated list of module:level
+// pairs, e.g. x/mymodule:debug,p2p:error. The modules can also be prefixed with "module=",
+// e.g. module=x/mymodule:debug. The level of the rest of the modules is set with a level
+// without module or with the * module, it's info by default.
+func FilterLevels(levels string) FilterOption {
+	return func(f *Filter) error {
+		for _, l := range strings.Split(levels, ",") {
+			l = strings.TrimSpace(l)
+			if l == "" {
+				continue
+			}
+
+			module := defaultModule
+			if i := strings.LastIndex(l, ":"); i >= 0 {
+				module, l = strings.TrimPrefix(l[:i], modulePrefix), l[i+1:]
+			}
+
+			if module == "" || strings.Contains(module, "=") {
+				return fmt.Errorf("invalid log module %q, use a comma separated list of module:level pairs", module)
+			}
+
+			level, err := ParseLevel(l)
+			if err != nil {
+				return err
+			}
+
+			f.levels[module] = level
+		}
+
+		return nil
+	}
+}
+
+// FilterMatch selects the log entries that match a regular expression.
+func FilterMatch(pattern string) FilterOption {
+	return func(f *Filter) (err error) {
+		if pattern == "" {
+			return nil
+		}
+
+		if f.match, err = regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid log match pattern: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// NewFilter creates a new log entry filter.
+func NewFilter(options ...FilterOption) (Filter, error) {
+	f := Filter{
+		levels: map[string]Level{defaultModule: DefaultLevel},
+	}
+
+	for _, apply := range options {
+		if err := apply(&f); err != nil {
+			return Filter{}, err
+		}
+	}
+
+	return f, nil
+}
+
+// MinLevel returns the lowest level selected by the filter, which is the level the node must log with.
+func (f Filter) MinLevel() Level {
+	min := f.levels[defaultModule]
+	for _, l := range f.levels {
+		if l < min {
+			min = l
+		}
+	}
+
+	return min
+}
+
+// Allow checks if a log entry is selected by the filter.
+func (f Filter) Allow(e Entry) bool {
+	level, ok := f.levels[e.Module]
+	if !ok {
+		level = f.levels[defaultModule]
+	}
+
+	if e.Level < level {
+		return false
+	}
+
+	return f.Match(e.Text())
+}
+
+// Match checks if a log line matches the pattern of the filter.
+func (f Filter) Match(line string) bool {
+	return f.match == nil || f.match.MatchString(line)
+}
+
+// Text returns the log entry as plain text without the time.
+func (e Entry) Text() string {
+	var b strings.Builder
+
+	b.WriteString(e.Level.String())
+	if e.Module != "" {
+		b.WriteString(" [" + e.Module + "]")
+	}
+	b.WriteString(" " + e.Message)
+
+	for _, f := range e.Fields {
+		b.WriteString(" " + f.Key + "=" + quote(f.Value))
+	}
+
+	return b.String()
+}
+
+// quote quotes the values with spaces.
+func quote(v string) string {
+	if strings.ContainsAny(v, " \t\n\"") {
+		return strconv.Quote(v)
+	}
+
+	return v
+}
@@ -0,0 +1,129 @@
+package nodelog
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want Entry
+		ok   bool
+	}{
+		{
+			name: "zerolog entry",
+			line: `{"level":"info","module":"consensus","height":5,"hash":"ABC","time":"2022-09-01T10:00:00Z","message":"finalizing commit"}`,
+			want: Entry{
+				Time:    time.Date(2022, 9, 1, 10, 0, 0, 0, time.UTC),
+				Level:   LevelInfo,
+				Module:  "consensus",
+				Message: "finalizing commit",
+				Fields:  []Field{{"height", "5"}, {"hash", "ABC"}},
+			},
+			ok: true,
+		},
+		{
+			name: "tendermint entry",
+			line: `{"level":"error","ts":"2022-09-01T10:00:00Z","_msg":"dial failed","module":"p2p","err":"connection refused"}`,
+			want: Entry{
+				Time:    time.Date(2022, 9, 1, 10, 0, 0, 0, time.UTC),
+				Level:   LevelError,
+				Module:  "p2p",
+				Message: "dial failed",
+				Fields:  []Field{{"err", "connection refused"}},
+			},
+			ok: true,
+		},
+		{
+			name: "plain line",
+			line: "Error: failed to load the genesis",
+		},
+		{
+			name: "invalid level",
+			line: `{"level":"verbose","message":"hello"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, ok := Parse([]byte(tt.line))
+			require.Equal(t, tt.ok, ok)
+			if tt.ok {
+				require.Equal(t, tt.want, e)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	f, err := NewFilter(FilterLevels("x/mars:debug, p2p:error"))
+	require.NoError(t, err)
+	require.Equal(t, LevelDebug, f.MinLevel())
+
+	require.True(t, f.Allow(Entry{Level: LevelDebug, Module: "x/mars"}))
+	require.False(t, f.Allow(Entry{Level: LevelInfo, Module: "p2p"}))
+	require.True(t, f.Allow(Entry{Level: LevelError, Module: "p2p"}))
+	require.True(t, f.Allow(Entry{Level: LevelInfo, Module: "consensus"}))
+	require.False(t, f.Allow(Entry{Level: LevelDebug, Module: "consensus"}))
+
+	f, err = NewFilter(FilterLevels("warn,x/mars:info"), FilterMatch(`height=\d+`))
+	require.NoError(t, err)
+	require.Equal(t, LevelInfo, f.MinLevel())
+
+	require.False(t, f.Allow(Entry{Level: LevelInfo, Module: "consensus", Fields: []Field{{"height", "5"}}}))
+	require.True(t, f.Allow(Entry{Level: LevelInfo, Module: "x/mars", Fields: []Field{{"height", "5"}}}))
+	require.False(t, f.Allow(Entry{Level: LevelInfo, Module: "x/mars"}))
+
+	f, err = NewFilter(FilterLevels("module=x/mymodule:debug,p2p:error"))
+	require.NoError(t, err)
+	require.True(t, f.Allow(Entry{Level: LevelDebug, Module: "x/mymodule"}))
+	require.False(t, f.Allow(Entry{Level: LevelInfo, Module: "p2p"}))
+
+	_, err = NewFilter(FilterLevels("x/mars:verbose"))
+	require.Error(t, err)
+
+	_, err = NewFilter(FilterLevels("name=x/mars:debug"))
+	require.Error(t, err)
+
+	_, err = NewFilter(FilterMatch("("))
+	require.Error(t, err)
+}
+
+func TestWriter(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	t.Cleanup(func() { color.NoColor = noColor })
+
+	f, err := NewFilter(FilterLevels("p2p:error"))
+	require.NoError(t, err)
+
+	var out, tee bytes.Buffer
+	w := NewWriter(&out, WithFilter(f), WithPrefix("mars | "), WithTee(&tee))
+
+	lines := `{"level":"info","module":"consensus","height":5,"message":"finalizing commit"}
+{"level":"info","module":"p2p","message":"dialing peer"}
+panic: store not found
+{"level":"error","module":"x/mars","msg":"ignored","message":"invalid \"denom\""`
+
+	// the lines are written in chunks that split them
+	for i := 0; i < len(lines); i += 10 {
+		end := i + 10
+		if end > len(lines) {
+			end = len(lines)
+		}
+		_, err := w.Write([]byte(lines[i:end]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Flush())
+
+	require.Equal(t, `mars | INF [consensus] finalizing commit height=5
+mars | panic: store not found
+mars | {"level":"error","module":"x/mars","msg":"ignored","message":"invalid \"denom\""
+`, out.String())
+	require.Equal(t, lines+"\n", tee.String())
+}
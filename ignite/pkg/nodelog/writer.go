@@ -0,0 +1,163 @@
+package nodelog
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+const timeFormat = "15:04:05.000"
+
+var (
+	levelColors = map[Level]func(a ...interface{}) string{
+		LevelDebug: color.New(color.FgBlue).SprintFunc(),
+		LevelInfo:  color.New(color.FgGreen).SprintFunc(),
+		LevelWarn:  color.New(color.FgYellow).SprintFunc(),
+		LevelError: color.New(color.FgRed).SprintFunc(),
+	}
+
+	levelLabels = map[Level]string{
+		LevelDebug: "DBG",
+		LevelInfo:  "INF",
+		LevelWarn:  "WRN",
+		LevelError: "ERR",
+	}
+
+	timeColor   = color.New(color.Faint).SprintFunc()
+	moduleColor = color.New(color.FgMagenta).SprintFunc()
+	keyColor    = color.New(color.FgCyan).SprintFunc()
+)
+
+// Writer is an io.Writer that renders the JSON log lines of a node.
+// The lines that are not JSON log entries are written as they are.
+type Writer struct {
+	out    io.Writer
+	tee    io.Writer
+	filter Filter
+	prefix string
+	buf    []byte
+}
+
+// WriterOption configures a writer.
+type WriterOption func(*Writer)
+
+// WithFilter sets the filter of the log entries that are written.
+func WithFilter(f Filter) WriterOption {
+	return func(w *Writer) {
+		w.filter = f
+	}
+}
+
+// WithPrefix sets a prefix that is added to each written line.
+func WithPrefix(prefix string) WriterOption {
+	return func(w *Writer) {
+		w.prefix = prefix
+	}
+}
+
+// WithTee sets a writer where all the lines are written as they are, without filtering them.
+func WithTee(tee io.Writer) WriterOption {
+	return func(w *Writer) {
+		w.tee = tee
+	}
+}
+
+// NewWriter returns a new Writer that writes the rendered log lines to out.
+func NewWriter(out io.Writer, options ...WriterOption) *Writer {
+	w := &Writer{out: out}
+	w.filter, _ = NewFilter()
+
+	for _, apply := range options {
+		apply(w)
+	}
+
+	return w
+}
+
+// Write implements io.Writer.
+// The log lines are written once they are complete.
+func (w *Writer) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		line := w.buf[:i+1]
+		if err := w.writeLine(line); err != nil {
+			return 0, err
+		}
+
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(p), nil
+}
+
+// Flush writes the last line when it's not complete.
+func (w *Writer) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	line := append(w.buf, '\n')
+	w.buf = nil
+
+	return w.writeLine(line)
+}
+
+func (w *Writer) writeLine(line []byte) error {
+	if w.tee != nil {
+		if _, err := w.tee.Write(line); err != nil {
+			return err
+		}
+	}
+
+	trimmed := bytes.TrimSpace(line)
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	var text string
+	if e, ok := Parse(trimmed); ok {
+		if !w.filter.Allow(e) {
+			return nil
+		}
+		text = Render(e)
+	} else {
+		text = string(trimmed)
+		if !w.filter.Match(text) {
+			return nil
+		}
+	}
+
+	_, err := io.WriteString(w.out, w.prefix+text+"\n")
+	return err
+}
+
+// Render returns a log entry as a colorized line of text.
+func Render(e Entry) string {
+	var b strings.Builder
+
+	if !e.Time.IsZero() {
+		b.WriteString(timeColor(e.Time.Local().Format(timeFormat)) + " ")
+	}
+
+	b.WriteString(levelColors[e.Level](levelLabels[e.Level]))
+
+	if e.Module != "" {
+		b.WriteString(" " + moduleColor("["+e.Module+"]"))
+	}
+
+	b.WriteString(" " + e.Message)
+
+	for _, f := range e.Fields {
+		b.WriteString(" " + keyColor(f.Key+"=") + quote(f.Value))
+	}
+
+	return b.String()
+}
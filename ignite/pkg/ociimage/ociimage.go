@@ -0,0 +1,407 @@
+// Package ociimage creates container images in the OCI image layout format
+// without a container runtime, see https://github.com/opencontainers/image-spec.
+package ociimage
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// MediaTypeIndex is the media type of an image index.
+	MediaTypeIndex = "application/vnd.oci.image.index.v1+json"
+
+	// MediaTypeManifest is the media type of an image manifest.
+	MediaTypeManifest = "application/vnd.oci.image.manifest.v1+json"
+
+	// MediaTypeConfig is the media type of an image config.
+	MediaTypeConfig = "application/vnd.oci.image.config.v1+json"
+
+	// MediaTypeLayer is the media type of a gzip compressed filesystem layer.
+	MediaTypeLayer = "application/vnd.oci.image.layer.v1.tar+gzip"
+
+	// AnnotationRefName is the annotation with the reference name of an image in the layout index.
+	AnnotationRefName = "org.opencontainers.image.ref.name"
+
+	// LayoutFile is the file that marks the base of an image layout.
+	LayoutFile = "oci-layout"
+
+	// IndexFile is the file with the index of the images of an image layout.
+	IndexFile = "index.json"
+
+	layoutVersion = "1.0.0"
+	schemaVersion = 2
+	blobsDir      = "blobs/sha256"
+	modeSticky    = 0o1000
+)
+
+// File is a file or a directory of a filesystem layer.
+type File struct {
+	// Path is the absolute path of the file in the image.
+	Path string
+
+	// Mode is the mode of the file, directories must have fs.ModeDir set.
+	Mode fs.FileMode
+
+	// Data is the content of the file.
+	Data []byte
+
+	// Source is the path of a local file with the content of the file.
+	// It's used instead of Data when set.
+	Source string
+}
+
+// Layer is a filesystem layer of an image.
+type Layer struct {
+	// Files are the files of the layer, missing parent directories are added to the layer.
+	Files []File
+
+	// CreatedBy describes how the layer was created in the history of the image.
+	CreatedBy string
+}
+
+// Image is a container image for a platform.
+type Image struct {
+	// Name is the reference name of the image in the layout, e.g. its tag.
+	Name         string
+	OS           string
+	Architecture string
+	Created      time.Time
+	User         string
+	Env          []string
+	Entrypoint   []string
+	Cmd          []string
+	WorkingDir   string
+
+	// ExposedPorts are the ports exposed by the container, e.g. 26657/tcp.
+	ExposedPorts []string
+	Labels       map[string]string
+	Layers       []Layer
+}
+
+// Descriptor describes a content of an image layout.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Platform    *Platform         `json:"platform,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Platform is the platform an image runs on.
+type Platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+}
+
+type layout struct {
+	ImageLayoutVersion string `json:"imageLayoutVersion"`
+}
+
+type index struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType"`
+	Manifests     []Descriptor `json:"manifests"`
+}
+
+type manifest struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType"`
+	Config        Descriptor   `json:"config"`
+	Layers        []Descriptor `json:"layers"`
+}
+
+type imageConfig struct {
+	Created      string          `json:"created"`
+	Architecture string          `json:"architecture"`
+	OS           string          `json:"os"`
+	Config       containerConfig `json:"config"`
+	RootFS       rootFS          `json:"rootfs"`
+	History      []history       `json:"history"`
+}
+
+type containerConfig struct {
+	User         string              `json:"User,omitempty"`
+	ExposedPorts map[string]struct{} `json:"ExposedPorts,omitempty"`
+	Env          []string            `json:"Env,omitempty"`
+	Entrypoint   []string            `json:"Entrypoint,omitempty"`
+	Cmd          []string            `json:"Cmd,omitempty"`
+	WorkingDir   string              `json:"WorkingDir,omitempty"`
+	Labels       map[string]string   `json:"Labels,omitempty"`
+}
+
+type rootFS struct {
+	Type    string   `json:"type"`
+	DiffIDs []string `json:"diff_ids"`
+}
+
+type history struct {
+	Created   string `json:"created"`
+	CreatedBy string `json:"created_by,omitempty"`
+}
+
+// WriteLayout writes an image to dir as an OCI image layout and returns the descriptor of its manifest.
+// The layout is reproducible: the files of the layers have no owner and their modification time
+// is the creation time of the image, so the same image always has the same digest.
+func WriteLayout(dir string, img Image) (Descriptor, error) {
+	if err := os.MkdirAll(filepath.Join(dir, blobsDir), 0o755); err != nil {
+		return Descriptor{}, err
+	}
+
+	created := img.Created.UTC().Truncate(time.Second)
+
+	config := imageConfig{
+		Created:      created.Format(time.RFC3339),
+		Architecture: img.Architecture,
+		OS:           img.OS,
+		Config: containerConfig{
+			User:       img.User,
+			Env:        img.Env,
+			Entrypoint: img.Entrypoint,
+			Cmd:        img.Cmd,
+			WorkingDir: img.WorkingDir,
+			Labels:     img.Labels,
+		},
+		RootFS: rootFS{Type: "layers", DiffIDs: []string{}},
+	}
+
+	if len(img.ExposedPorts) > 0 {
+		config.Config.ExposedPorts = make(map[string]struct{})
+		for _, p := range img.ExposedPorts {
+			config.Config.ExposedPorts[p] = struct{}{}
+		}
+	}
+
+	m := manifest{
+		SchemaVersion: schemaVersion,
+		MediaType:     MediaTypeManifest,
+		Layers:        []Descriptor{},
+	}
+
+	for _, l := range img.Layers {
+		desc, diffID, err := writeLayer(dir, l, created)
+		if err != nil {
+			return Descriptor{}, err
+		}
+
+		m.Layers = append(m.Layers, desc)
+		config.RootFS.DiffIDs = append(config.RootFS.DiffIDs, diffID)
+		config.History = append(config.History, history{Created: config.Created, CreatedBy: l.CreatedBy})
+	}
+
+	var err error
+	if m.Config, err = writeJSONBlob(dir, MediaTypeConfig, config); err != nil {
+		return Descriptor{}, err
+	}
+
+	desc, err := writeJSONBlob(dir, MediaTypeManifest, m)
+	if err != nil {
+		return Descriptor{}, err
+	}
+
+	desc.Platform = &Platform{Architecture: img.Architecture, OS: img.OS}
+	if img.Name != "" {
+		desc.Annotations = map[string]string{AnnotationRefName: img.Name}
+	}
+
+	idx := index{
+		SchemaVersion: schemaVersion,
+		MediaType:     MediaTypeIndex,
+		Manifests:     []Descriptor{desc},
+	}
+
+	if err := writeJSON(filepath.Join(dir, IndexFile), idx); err != nil {
+		return Descriptor{}, err
+	}
+
+	if err := writeJSON(filepath.Join(dir, LayoutFile), layout{ImageLayoutVersion: layoutVersion}); err != nil {
+		return Descriptor{}, err
+	}
+
+	return desc, nil
+}
+
+// writeLayer writes a layer as a gzip compressed blob and returns its descriptor and the
+// digest of the uncompressed layer.
+func writeLayer(dir string, l Layer, mtime time.Time) (desc Descriptor, diffID string, err error) {
+	f, err := os.CreateTemp(filepath.Join(dir, blobsDir), "layer-")
+	if err != nil {
+		return Descriptor{}, "", err
+	}
+	defer func() {
+		f.Close()
+		if err != nil {
+			os.Remove(f.Name())
+		}
+	}()
+
+	var (
+		blobHash = sha256.New()
+		diffHash = sha256.New()
+		gw       = gzip.NewWriter(io.MultiWriter(f, blobHash))
+		tw       = tar.NewWriter(io.MultiWriter(gw, diffHash))
+	)
+
+	for _, file := range layerFiles(l.Files) {
+		if err := writeLayerFile(tw, file, mtime); err != nil {
+			return Descriptor{}, "", err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return Descriptor{}, "", err
+	}
+
+	if err := gw.Close(); err != nil {
+		return Descriptor{}, "", err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return Descriptor{}, "", err
+	}
+
+	if err := f.Close(); err != nil {
+		return Descriptor{}, "", err
+	}
+
+	desc = Descriptor{
+		MediaType: MediaTypeLayer,
+		Digest:    digest(blobHash),
+		Size:      info.Size(),
+	}
+
+	if err := os.Rename(f.Name(), blobPath(dir, desc.Digest)); err != nil {
+		return Descriptor{}, "", err
+	}
+
+	return desc, digest(diffHash), nil
+}
+
+// layerFiles returns the files of a layer sorted by path, including their missing parent directories.
+func layerFiles(files []File) []File {
+	byPath := make(map[string]File)
+	for _, f := range files {
+		f.Path = path.Clean("/" + f.Path)
+		byPath[f.Path] = f
+
+		for dir := path.Dir(f.Path); dir != "/"; dir = path.Dir(dir) {
+			if _, ok := byPath[dir]; !ok {
+				byPath[dir] = File{Path: dir, Mode: fs.ModeDir | 0o755}
+			}
+		}
+	}
+
+	sorted := make([]File, 0, len(byPath))
+	for _, f := range byPath {
+		sorted = append(sorted, f)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Path < sorted[j].Path
+	})
+
+	return sorted
+}
+
+func writeLayerFile(tw *tar.Writer, f File, mtime time.Time) error {
+	header := &tar.Header{
+		Name:    strings.TrimPrefix(f.Path, "/"),
+		Mode:    int64(f.Mode.Perm()),
+		ModTime: mtime,
+		Format:  tar.FormatPAX,
+	}
+
+	if f.Mode&fs.ModeSticky != 0 {
+		header.Mode |= modeSticky
+	}
+
+	if f.Mode.IsDir() {
+		header.Typeflag = tar.TypeDir
+		header.Name += "/"
+		return tw.WriteHeader(header)
+	}
+
+	header.Typeflag = tar.TypeReg
+
+	if f.Source == "" {
+		header.Size = int64(len(f.Data))
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		_, err := tw.Write(f.Data)
+		return err
+	}
+
+	src, err := os.Open(f.Source)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s: only regular files can be added to a layer", f.Source)
+	}
+
+	header.Size = info.Size()
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tw, src)
+	return err
+}
+
+func writeJSONBlob(dir, mediaType string, v interface{}) (Descriptor, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return Descriptor{}, err
+	}
+
+	h := sha256.New()
+	h.Write(data)
+
+	desc := Descriptor{
+		MediaType: mediaType,
+		Digest:    digest(h),
+		Size:      int64(len(data)),
+	}
+
+	return desc, os.WriteFile(blobPath(dir, desc.Digest), data, 0o644)
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+func blobPath(dir, digest string) string {
+	return filepath.Join(dir, blobsDir, strings.TrimPrefix(digest, "sha256:"))
+}
+
+func digest(h hash.Hash) string {
+	return "sha256:" + hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,145 @@
+package ociimage
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteLayout(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "marsd")
+	require.NoError(t, os.WriteFile(binary, []byte("binary"), 0o755))
+
+	img := Image{
+		Name:         "v0.1.0",
+		OS:           "linux",
+		Architecture: "amd64",
+		Created:      time.Unix(1660000000, 0),
+		Entrypoint:   []string{"/usr/local/bin/marsd", "start"},
+		ExposedPorts: []string{"26657/tcp"},
+		Labels:       map[string]string{"org.opencontainers.image.version": "v0.1.0"},
+		Layers: []Layer{
+			{
+				Files: []File{
+					{Path: "/etc/passwd", Mode: 0o644, Data: []byte("root:x:0:0:root:/root:/sbin/nologin\n")},
+					{Path: "/tmp", Mode: fs.ModeDir | fs.ModeSticky | 0o777},
+				},
+			},
+			{
+				Files: []File{{Path: "/usr/local/bin/marsd", Mode: 0o755, Source: binary}},
+			},
+		},
+	}
+
+	dir := t.TempDir()
+	desc, err := WriteLayout(dir, img)
+	require.NoError(t, err)
+	require.Equal(t, MediaTypeManifest, desc.MediaType)
+	require.Equal(t, "v0.1.0", desc.Annotations[AnnotationRefName])
+
+	// the same image always has the same digest
+	other, err := WriteLayout(t.TempDir(), img)
+	require.NoError(t, err)
+	require.Equal(t, desc.Digest, other.Digest)
+
+	var l layout
+	readJSON(t, filepath.Join(dir, LayoutFile), &l)
+	require.Equal(t, layoutVersion, l.ImageLayoutVersion)
+
+	var idx index
+	readJSON(t, filepath.Join(dir, IndexFile), &idx)
+	require.Equal(t, []Descriptor{desc}, idx.Manifests)
+
+	var m manifest
+	readBlob(t, dir, desc, &m)
+	require.Len(t, m.Layers, 2)
+
+	var config imageConfig
+	readBlob(t, dir, m.Config, &config)
+	require.Equal(t, "2022-08-08T23:06:40Z", config.Created)
+	require.Equal(t, img.Entrypoint, config.Config.Entrypoint)
+	require.Contains(t, config.Config.ExposedPorts, "26657/tcp")
+	require.Len(t, config.RootFS.DiffIDs, 2)
+
+	// the layers include the missing parent dirs
+	files := readLayer(t, dir, m.Layers[1], config.RootFS.DiffIDs[1])
+	require.Equal(t, []string{"usr/", "usr/local/", "usr/local/bin/", "usr/local/bin/marsd"}, fileNames(files))
+	require.Equal(t, "binary", string(files[3].data))
+
+	files = readLayer(t, dir, m.Layers[0], config.RootFS.DiffIDs[0])
+	require.Equal(t, []string{"etc/", "etc/passwd", "tmp/"}, fileNames(files))
+	require.Equal(t, int64(0o1777), files[2].header.Mode)
+}
+
+type layerFile struct {
+	header *tar.Header
+	data   []byte
+}
+
+func readJSON(t *testing.T, path string, v interface{}) {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
+
+func readBlob(t *testing.T, dir string, desc Descriptor, v interface{}) {
+	data, err := os.ReadFile(blobPath(dir, desc.Digest))
+	require.NoError(t, err)
+	require.Equal(t, desc.Size, int64(len(data)))
+	require.NoError(t, json.Unmarshal(data, v))
+}
+
+func readLayer(t *testing.T, dir string, desc Descriptor, diffID string) (files []layerFile) {
+	f, err := os.Open(blobPath(dir, desc.Digest))
+	require.NoError(t, err)
+	defer f.Close()
+
+	blobHash := sha256.New()
+	gr, err := gzip.NewReader(io.TeeReader(f, blobHash))
+	require.NoError(t, err)
+
+	diffHash := sha256.New()
+	tr := tar.NewReader(io.TeeReader(gr, diffHash))
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+
+		files = append(files, layerFile{header, data})
+	}
+
+	// read the rest of the streams to hash them completely
+	_, err = io.Copy(io.Discard, gr)
+	require.NoError(t, err)
+	_, err = io.Copy(io.Discard, f)
+	require.NoError(t, err)
+
+	require.Equal(t, desc.Digest, digest(blobHash))
+	require.Equal(t, diffID, digest(diffHash))
+
+	return files
+}
+
+func fileNames(files []layerFile) []string {
+	names := make([]string, len(files))
+	for i, f := range files {
+		names[i] = f.header.Name
+	}
+
+	return names
+}
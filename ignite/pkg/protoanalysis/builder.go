@@ -21,6 +21,7 @@ func build(p pkg) Package {
 		Path:     p.dir,
 		Files:    br.buildFiles(),
 		Messages: br.buildMessages(),
+		Enums:    br.buildEnums(),
 		Services: br.toServices(p.services()),
 	}
 
@@ -89,6 +90,31 @@ func (b builder) buildMessages() (messages []Message) {
 	return messages
 }
 
+func (b builder) buildEnums() (enums []Enum) {
+	for _, f := range b.p.files {
+		for _, enum := range f.enums {
+			// enums defined inside messages are not part of the package scope.
+			if _, ok := enum.Parent.(*proto.Proto); !ok {
+				continue
+			}
+
+			e := Enum{
+				Name: enum.Name,
+				Path: f.path,
+			}
+			for _, elem := range enum.Elements {
+				if field, ok := elem.(*proto.EnumField); ok {
+					e.Values = append(e.Values, field.Name)
+				}
+			}
+
+			enums = append(enums, e)
+		}
+	}
+
+	return enums
+}
+
 func (b builder) toServices(ps []*proto.Service) (services []Service) {
 	for _, service := range ps {
 		s := Service{
@@ -33,6 +33,9 @@ type Package struct {
 	// Messages is a list of proto messages defined in the package.
 	Messages []Message
 
+	// Enums is a list of top level proto enums defined in the package.
+	Enums []Enum
+
 	// Services is a list of RPC services.
 	Services []Service
 }
@@ -83,6 +86,18 @@ type Message struct {
 	HighestFieldNumber int
 }
 
+// Enum is a proto enum.
+type Enum struct {
+	// Name of the enum.
+	Name string
+
+	// Path of the file where enum is defined at.
+	Path string
+
+	// Values is the list of the names of the enum values.
+	Values []string
+}
+
 // Service is an RPC service.
 type Service struct {
 	// Name of the services.
@@ -62,6 +62,7 @@ type file struct {
 	imports  []string // imported protos.
 	options  []*proto.Option
 	messages []*proto.Message
+	enums    []*proto.Enum
 	services []*proto.Service
 }
 
@@ -81,6 +82,14 @@ func (p *pkg) messages() (m []*proto.Message) {
 	return
 }
 
+func (p *pkg) enums() (e []*proto.Enum) {
+	for _, f := range p.files {
+		e = append(e, f.enums...)
+	}
+
+	return
+}
+
 func (p *pkg) services() (s []*proto.Service) {
 	for _, f := range p.files {
 		s = append(s, f.services...)
@@ -133,6 +142,7 @@ func (p *parser) parseFile(path string) error {
 		proto.WithImport(func(s *proto.Import) { pf.imports = append(pf.imports, s.Filename) }),
 		proto.WithOption(func(o *proto.Option) { pf.options = append(pf.options, o) }),
 		proto.WithMessage(func(m *proto.Message) { pf.messages = append(pf.messages, m) }),
+		proto.WithEnum(func(e *proto.Enum) { pf.enums = append(pf.enums, e) }),
 		proto.WithService(func(s *proto.Service) { pf.services = append(pf.services, s) }),
 	)
 
@@ -287,3 +287,17 @@ func TestLiquidity(t *testing.T) {
 
 	require.Equal(t, expected, packages)
 }
+
+func TestEnums(t *testing.T) {
+	packages, err := Parse(context.Background(), nil, "testdata/enums")
+	require.NoError(t, err)
+
+	expected := []Enum{
+		{
+			Name:   "Status",
+			Path:   "testdata/enums/enums.proto",
+			Values: []string{"STATUS_PENDING", "STATUS_ACTIVE"},
+		},
+	}
+	require.Equal(t, expected, packages[0].Enums)
+}
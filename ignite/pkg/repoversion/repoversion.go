@@ -3,6 +3,7 @@ package repoversion
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
@@ -12,6 +13,9 @@ import (
 type Version struct {
 	Tag  string
 	Hash string
+
+	// Time is the commit time of the HEAD.
+	Time time.Time
 }
 
 func Determine(path string) (v Version, err error) {
@@ -109,8 +113,14 @@ func Determine(path string) (v Version, err error) {
 		subHeadHash = subHeadHash[:subHashLen]
 	}
 
+	headCommit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return Version{}, err
+	}
+
 	v.Tag = tag
 	v.Hash = headHashText
+	v.Time = headCommit.Committer.When
 
 	if tagHashIndex > 0 {
 		v.Tag = fmt.Sprintf("%s-%s", tag, subHeadHash)
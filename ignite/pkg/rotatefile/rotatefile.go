@@ -0,0 +1,146 @@
+// Package rotatefile provides a file writer that rotates the file when it reaches a max size.
+package rotatefile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// DefaultMaxSize is the default max size of a file in bytes.
+	DefaultMaxSize = 10 * 1024 * 1024
+
+	// DefaultMaxBackups is the default number of rotated files that are kept.
+	DefaultMaxBackups = 3
+)
+
+// File is a file writer that rotates the file when it reaches a max size.
+// The rotated files are renamed with a number suffix, where path.1 is the most recent one.
+// File is safe for concurrent use.
+type File struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// Option configures a file.
+type Option func(*File)
+
+// MaxSize sets the max size of the file in bytes.
+func MaxSize(size int64) Option {
+	return func(f *File) {
+		f.maxSize = size
+	}
+}
+
+// MaxBackups sets the number of rotated files that are kept.
+func MaxBackups(n int) Option {
+	return func(f *File) {
+		f.maxBackups = n
+	}
+}
+
+// Open opens the file at path for appending, creating it and its directory when they don't exist.
+func Open(path string, options ...Option) (*File, error) {
+	f := &File{
+		path:       path,
+		maxSize:    DefaultMaxSize,
+		maxBackups: DefaultMaxBackups,
+	}
+
+	for _, apply := range options {
+		apply(f)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Path returns the path of the file.
+func (f *File) Path() string {
+	return f.path
+}
+
+// Write implements io.Writer.
+// The file is rotated before writing when the data doesn't fit in it.
+func (f *File) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
+
+// Close closes the file.
+func (f *File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Close()
+}
+
+func (f *File) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = info.Size()
+
+	return nil
+}
+
+func (f *File) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+
+	if f.maxBackups > 0 {
+		// shift the rotated files, the oldest one is overwritten
+		for i := f.maxBackups - 1; i > 0; i-- {
+			err := os.Rename(f.backupPath(i), f.backupPath(i+1))
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		if err := os.Rename(f.path, f.backupPath(1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(f.path); err != nil {
+		return err
+	}
+
+	return f.open()
+}
+
+func (f *File) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", f.path, i)
+}
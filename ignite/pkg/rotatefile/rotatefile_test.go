@@ -0,0 +1,44 @@
+package rotatefile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "node.log")
+
+	f, err := Open(path, MaxSize(10), MaxBackups(2))
+	require.NoError(t, err)
+
+	for _, line := range []string{"line 1\n", "line 2\n", "line 3\n", "line 4\n"} {
+		_, err := f.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+
+	requireFile(t, path, "line 4\n")
+	requireFile(t, path+".1", "line 3\n")
+	requireFile(t, path+".2", "line 2\n")
+	require.NoFileExists(t, path+".3")
+
+	// the file is appended when it's opened again
+	f, err = Open(path, MaxSize(20))
+	require.NoError(t, err)
+	_, err = f.Write([]byte("line 5\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	requireFile(t, path, "line 4\nline 5\n")
+}
+
+func requireFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, content, string(data))
+}
@@ -0,0 +1,192 @@
+// Package sbom creates software bills of materials of Go modules.
+package sbom
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/mod/module"
+
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+)
+
+const (
+	cycloneDXFormat      = "CycloneDX"
+	cycloneDXSpecVersion = "1.4"
+
+	componentTypeApplication = "application"
+	componentTypeLibrary     = "library"
+)
+
+// BOM is a CycloneDX software bill of materials in its JSON representation.
+type BOM struct {
+	BOMFormat    string       `json:"bomFormat"`
+	SpecVersion  string       `json:"specVersion"`
+	Version      int          `json:"version"`
+	Metadata     Metadata     `json:"metadata"`
+	Components   []Component  `json:"components"`
+	Dependencies []Dependency `json:"dependencies"`
+}
+
+// Metadata describes the component the BOM is created for.
+type Metadata struct {
+	Timestamp string    `json:"timestamp"`
+	Tools     []Tool    `json:"tools,omitempty"`
+	Component Component `json:"component"`
+}
+
+// Tool is a tool used to create the BOM.
+type Tool struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// Component is a software component, in this case a Go module.
+type Component struct {
+	BOMRef  string `json:"bom-ref"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl"`
+}
+
+// Dependency lists the components directly required by a component.
+type Dependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// Option configures the BOM.
+type Option func(*BOM)
+
+// WithTool adds a tool used to create the BOM.
+func WithTool(name, version string) Option {
+	return func(b *BOM) {
+		b.Metadata.Tools = append(b.Metadata.Tools, Tool{Name: name, Version: version})
+	}
+}
+
+// NewCycloneDX creates a CycloneDX BOM for a Go application from the resolved module graph of its main module.
+// modules is the build list of the main module and requirements is the module requirement graph.
+// The version of the application is used as the version of the main module.
+func NewCycloneDX(
+	version string,
+	modules []gomodule.Module,
+	requirements []gomodule.Requirement,
+	timestamp time.Time,
+	options ...Option,
+) (*BOM, error) {
+	var (
+		main     *gomodule.Module
+		selected = make(map[string]gomodule.Module)
+	)
+
+	for i, m := range modules {
+		if m.Main {
+			main = &modules[i]
+			continue
+		}
+
+		selected[m.Path] = m
+	}
+
+	if main == nil {
+		return nil, fmt.Errorf("the main module is missing from the build list")
+	}
+
+	mainComponent := newComponent(componentTypeApplication, module.Version{Path: main.Path, Version: version})
+
+	b := &BOM{
+		BOMFormat:   cycloneDXFormat,
+		SpecVersion: cycloneDXSpecVersion,
+		Version:     1,
+		Metadata: Metadata{
+			Timestamp: timestamp.UTC().Format(time.RFC3339),
+			Component: mainComponent,
+		},
+	}
+
+	for _, o := range options {
+		o(b)
+	}
+
+	refs := map[string]string{main.Path: mainComponent.BOMRef}
+
+	for _, m := range modules {
+		if m.Main {
+			continue
+		}
+
+		c := newComponent(componentTypeLibrary, m.Resolved())
+		refs[m.Path] = c.BOMRef
+		b.Components = append(b.Components, c)
+	}
+
+	sort.Slice(b.Components, func(i, j int) bool {
+		return b.Components[i].BOMRef < b.Components[j].BOMRef
+	})
+
+	// Only the requirements of the selected module versions are part of the resolved graph,
+	// and each requirement resolves to the selected version of the required module.
+	dependsOn := make(map[string]map[string]bool)
+
+	for _, r := range requirements {
+		if r.Module.Path == main.Path {
+			if r.Module.Version != "" {
+				continue
+			}
+		} else if m, ok := selected[r.Module.Path]; !ok || m.Version != r.Module.Version {
+			continue
+		}
+
+		ref, requiredRef := refs[r.Module.Path], refs[r.Requires.Path]
+		if requiredRef == "" {
+			continue
+		}
+
+		if dependsOn[ref] == nil {
+			dependsOn[ref] = make(map[string]bool)
+		}
+		dependsOn[ref][requiredRef] = true
+	}
+
+	b.Dependencies = append(b.Dependencies, newDependency(mainComponent.BOMRef, dependsOn))
+	for _, c := range b.Components {
+		b.Dependencies = append(b.Dependencies, newDependency(c.BOMRef, dependsOn))
+	}
+
+	return b, nil
+}
+
+func newComponent(componentType string, m module.Version) Component {
+	purl := PURL(m)
+
+	return Component{
+		BOMRef:  purl,
+		Type:    componentType,
+		Name:    m.Path,
+		Version: m.Version,
+		PURL:    purl,
+	}
+}
+
+func newDependency(ref string, dependsOn map[string]map[string]bool) Dependency {
+	d := Dependency{Ref: ref}
+	for r := range dependsOn[ref] {
+		d.DependsOn = append(d.DependsOn, r)
+	}
+
+	sort.Strings(d.DependsOn)
+
+	return d
+}
+
+// PURL returns the package URL of a Go module.
+func PURL(m module.Version) string {
+	if m.Version == "" {
+		return fmt.Sprintf("pkg:golang/%s", m.Path)
+	}
+
+	return fmt.Sprintf("pkg:golang/%s@%s", m.Path, m.Version)
+}
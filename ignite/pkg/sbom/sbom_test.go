@@ -0,0 +1,79 @@
+package sbom_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+	"github.com/ignite/cli/ignite/pkg/sbom"
+)
+
+func TestNewCycloneDX(t *testing.T) {
+	modules := []gomodule.Module{
+		{Path: "github.com/mars/mars", Main: true},
+		{Path: "github.com/cosmos/cosmos-sdk", Version: "v0.46.1"},
+		{Path: "github.com/gogo/protobuf", Version: "v1.3.3", Replace: &gomodule.Module{
+			Path:    "github.com/regen-network/protobuf",
+			Version: "v1.3.3-alpha.regen.1",
+		}},
+		{Path: "golang.org/x/text", Version: "v0.3.7", Indirect: true},
+	}
+
+	graph, err := gomodule.ParseGraph(strings.NewReader(`github.com/mars/mars github.com/cosmos/cosmos-sdk@v0.46.1
+github.com/mars/mars github.com/gogo/protobuf@v1.3.3
+github.com/cosmos/cosmos-sdk@v0.46.1 golang.org/x/text@v0.3.5
+github.com/cosmos/cosmos-sdk@v0.45.0 github.com/gogo/protobuf@v1.3.2
+golang.org/x/text@v0.3.5 golang.org/x/tools@v0.0.0-20180917221912-90fa682c2a6e
+`))
+	require.NoError(t, err)
+
+	timestamp := time.Date(2022, 9, 30, 10, 0, 0, 0, time.UTC)
+
+	bom, err := sbom.NewCycloneDX("1.0.0", modules, graph, timestamp, sbom.WithTool("ignite", "v0.24.0"))
+	require.NoError(t, err)
+
+	require.Equal(t, "CycloneDX", bom.BOMFormat)
+	require.Equal(t, "2022-09-30T10:00:00Z", bom.Metadata.Timestamp)
+	require.Equal(t, []sbom.Tool{{Name: "ignite", Version: "v0.24.0"}}, bom.Metadata.Tools)
+	require.Equal(t, sbom.Component{
+		BOMRef:  "pkg:golang/github.com/mars/mars@1.0.0",
+		Type:    "application",
+		Name:    "github.com/mars/mars",
+		Version: "1.0.0",
+		PURL:    "pkg:golang/github.com/mars/mars@1.0.0",
+	}, bom.Metadata.Component)
+
+	var refs []string
+	for _, c := range bom.Components {
+		refs = append(refs, c.BOMRef)
+	}
+	require.Equal(t, []string{
+		"pkg:golang/github.com/cosmos/cosmos-sdk@v0.46.1",
+		"pkg:golang/github.com/regen-network/protobuf@v1.3.3-alpha.regen.1",
+		"pkg:golang/golang.org/x/text@v0.3.7",
+	}, refs)
+
+	require.Equal(t, []sbom.Dependency{
+		{
+			Ref: "pkg:golang/github.com/mars/mars@1.0.0",
+			DependsOn: []string{
+				"pkg:golang/github.com/cosmos/cosmos-sdk@v0.46.1",
+				"pkg:golang/github.com/regen-network/protobuf@v1.3.3-alpha.regen.1",
+			},
+		},
+		{
+			Ref:       "pkg:golang/github.com/cosmos/cosmos-sdk@v0.46.1",
+			DependsOn: []string{"pkg:golang/golang.org/x/text@v0.3.7"},
+		},
+		{Ref: "pkg:golang/github.com/regen-network/protobuf@v1.3.3-alpha.regen.1"},
+		{Ref: "pkg:golang/golang.org/x/text@v0.3.7"},
+	}, bom.Dependencies)
+}
+
+func TestNewCycloneDXWithoutMainModule(t *testing.T) {
+	_, err := sbom.NewCycloneDX("1.0.0", nil, nil, time.Now())
+	require.EqualError(t, err, "the main module is missing from the build list")
+}
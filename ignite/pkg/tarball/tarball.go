@@ -4,8 +4,12 @@ import (
 	"archive/tar"
 	"compress/gzip"
 	"errors"
+	"fmt"
 	"io"
+	"io/fs"
+	"os"
 	"path/filepath"
+	"time"
 )
 
 var (
@@ -55,3 +59,75 @@ func ExtractFile(reader io.Reader, out io.Writer, fileName string) (string, erro
 		}
 	}
 }
+
+// Create writes a gzip tarball with the files of a directory to out.
+// The tarball is reproducible: the entries are sorted by path, their modification
+// time is set to mtime and they have no owner, so the same files always produce
+// the same tarball.
+func Create(out io.Writer, dir string, mtime time.Time) error {
+	gw := gzip.NewWriter(out)
+	if err := Write(gw, dir, mtime); err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+// Write writes an uncompressed tar archive with the files of a directory to out.
+// Like Create, the archive is reproducible.
+func Write(out io.Writer, dir string, mtime time.Time) error {
+	tw := tar.NewWriter(out)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return fmt.Errorf("%s: only files and directories can be archived", path)
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		header := &tar.Header{
+			Name:    filepath.ToSlash(name),
+			Mode:    int64(info.Mode().Perm()),
+			ModTime: mtime.UTC().Truncate(time.Second),
+			Format:  tar.FormatPAX,
+		}
+
+		if info.IsDir() {
+			header.Typeflag = tar.TypeDir
+			header.Name += "/"
+			return tw.WriteHeader(header)
+		}
+
+		header.Typeflag = tar.TypeReg
+		header.Size = info.Size()
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
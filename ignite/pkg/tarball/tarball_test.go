@@ -3,7 +3,9 @@ package tarball
 import (
 	"bytes"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -95,3 +97,28 @@ func TestExtractFile(t *testing.T) {
 		})
 	}
 }
+
+func TestCreate(t *testing.T) {
+	createTarball := func(mtime time.Time) []byte {
+		dir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "bin"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "bin", "marsd"), []byte("binary"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("readme"), 0o644))
+
+		// the modification time of the files is not archived
+		require.NoError(t, os.Chtimes(filepath.Join(dir, "README.md"), mtime, mtime))
+
+		var b bytes.Buffer
+		require.NoError(t, Create(&b, dir, time.Unix(1660000000, 0)))
+		return b.Bytes()
+	}
+
+	tarball := createTarball(time.Now())
+	require.Equal(t, tarball, createTarball(time.Now().Add(time.Hour)))
+
+	var out bytes.Buffer
+	path, err := ExtractFile(bytes.NewReader(tarball), &out, "marsd")
+	require.NoError(t, err)
+	require.Equal(t, "bin/marsd", path)
+	require.Equal(t, "binary", out.String())
+}
@@ -99,6 +99,15 @@ func Address(address string) string {
 	return address
 }
 
+// SplitScheme splits the scheme prefix of an address, e.g. "tcp://0.0.0.0:26657",
+// from the rest of the address. The scheme is empty when the address has no scheme.
+func SplitScheme(address string) (scheme, rest string) {
+	if i := strings.Index(address, "://"); i != -1 {
+		return address[:i], address[i+3:]
+	}
+	return "", address
+}
+
 func IsHTTP(address string) bool {
 	return strings.HasPrefix(address, "http")
 }
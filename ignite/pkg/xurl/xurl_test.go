@@ -37,6 +37,35 @@ func TestHTTPEnsurePort(t *testing.T) {
 	}
 }
 
+func TestSplitScheme(t *testing.T) {
+	cases := []struct {
+		name       string
+		addr       string
+		wantScheme string
+		wantRest   string
+	}{
+		{
+			name:       "with scheme",
+			addr:       "tcp://0.0.0.0:26657",
+			wantScheme: "tcp",
+			wantRest:   "0.0.0.0:26657",
+		},
+		{
+			name:     "without scheme",
+			addr:     "0.0.0.0:26657",
+			wantRest: "0.0.0.0:26657",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme, rest := SplitScheme(tt.addr)
+			require.Equal(t, tt.wantScheme, scheme)
+			require.Equal(t, tt.wantRest, rest)
+		})
+	}
+}
+
 func TestTCP(t *testing.T) {
 	cases := []struct {
 		name  string
@@ -3,28 +3,26 @@ package chain
 import (
 	"context"
 	"fmt"
-	"io"
-	"os"
 	"path/filepath"
-	"runtime"
+	"sort"
+	"strings"
 
-	"github.com/docker/docker/pkg/archive"
 	"github.com/pkg/errors"
 
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/chainconfig/config"
 	"github.com/ignite/cli/ignite/pkg/cache"
-	"github.com/ignite/cli/ignite/pkg/checksum"
 	"github.com/ignite/cli/ignite/pkg/cmdrunner"
 	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
 	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
 	"github.com/ignite/cli/ignite/pkg/dirchange"
+	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/goanalysis"
 	"github.com/ignite/cli/ignite/pkg/gocmd"
 	"github.com/ignite/cli/ignite/pkg/xstrings"
 )
 
 const (
-	releaseDir                   = "release"
-	releaseChecksumKey           = "release_checksum"
 	modChecksumKey               = "go_mod_checksum"
 	buildDirchangeCacheNamespace = "build.dirchange"
 )
@@ -53,14 +51,32 @@ func (c *Chain) build(
 	output string,
 	skipProto bool,
 ) (err error) {
+	// binaryPath is reported as the result of the build phase
+	var binaryPath string
+
+	start := c.phaseStarted(events.PhaseBuild)
+	defer func() { c.phaseDone(events.PhaseBuild, start, err, events.Message(binaryPath)) }()
+
 	defer func() {
-		var exitErr *exec.ExitError
+		var (
+			exitErr *exec.ExitError
+			hookErr *HookError
+		)
 
-		if errors.As(err, &exitErr) || errors.Is(err, goanalysis.ErrMultipleMainPackagesFound) {
+		if errors.As(err, &exitErr) || errors.As(err, &hookErr) || errors.Is(err, goanalysis.ErrMultipleMainPackagesFound) {
 			err = &CannotBuildAppError{err}
 		}
 	}()
 
+	conf, err := c.Config()
+	if err != nil {
+		return &CannotBuildAppError{err}
+	}
+
+	if err := c.runHook(ctx, hookPreBuild, conf.Hooks.PreBuild); err != nil {
+		return err
+	}
+
 	// generate from proto files
 	if !skipProto {
 		if err := c.generateFromConfig(ctx, cacheStorage); err != nil {
@@ -68,7 +84,7 @@ func (c *Chain) build(
 		}
 	}
 
-	buildFlags, err := c.preBuild(ctx, cacheStorage)
+	buildFlags, buildEnv, err := c.preBuild(ctx, cacheStorage)
 	if err != nil {
 		return err
 	}
@@ -83,158 +99,175 @@ func (c *Chain) build(
 		return err
 	}
 
-	return gocmd.BuildPath(ctx, output, binary, path, buildFlags)
-}
-
-// BuildRelease builds binaries for a release. targets is a list
-// of GOOS:GOARCH when provided. It defaults to your system when no targets provided.
-// prefix is used as prefix to tarballs containing each target.
-func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, output, prefix string, targets ...string) (releasePath string, err error) {
-	if prefix == "" {
-		prefix = c.app.Name
-	}
-	if len(targets) == 0 {
-		targets = []string{gocmd.BuildTarget(runtime.GOOS, runtime.GOARCH)}
+	buildOptions := []exec.Option{
+		exec.StepOption(step.Env(buildEnv...)),
 	}
 
-	// prepare for build.
-	if err := c.setup(); err != nil {
-		return "", err
+	if err := gocmd.BuildPath(ctx, output, binary, path, buildFlags, buildOptions...); err != nil {
+		return err
 	}
 
-	buildFlags, err := c.preBuild(ctx, cacheStorage)
-	if err != nil {
-		return "", err
+	binaryPath = binary
+	if output != "" {
+		binaryPath = filepath.Join(output, binary)
 	}
 
-	binary, err := c.Binary()
-	if err != nil {
-		return "", err
-	}
+	return c.runHook(ctx, hookPostBuild, conf.Hooks.PostBuild)
+}
 
-	mainPath, err := c.discoverMain(c.app.Path)
+func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (buildFlags, buildEnv []string, err error) {
+	conf, err := c.Config()
 	if err != nil {
-		return "", err
+		return nil, nil, err
 	}
 
-	releasePath = output
-	if releasePath == "" {
-		releasePath = filepath.Join(c.app.Path, releaseDir)
-		// reset the release dir.
-		if err := os.RemoveAll(releasePath); err != nil {
-			return "", err
-		}
-	}
-
-	if err := os.MkdirAll(releasePath, 0o755); err != nil {
-		return "", err
+	chainID, err := c.ID()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	for _, t := range targets {
-		// build binary for a target, tarball it and save it under the release dir.
-		goos, goarch, err := gocmd.ParseTarget(t)
-		if err != nil {
-			return "", err
-		}
-
-		out, err := os.MkdirTemp("", "")
-		if err != nil {
-			return "", err
-		}
-		defer os.RemoveAll(out)
+	buildFlags = goBuildFlags(conf.Build, c.ldFlags(conf, chainID))
+	buildEnv = goBuildEnv(conf.Build)
 
-		buildOptions := []exec.Option{
-			exec.StepOption(step.Env(
-				cmdrunner.Env(gocmd.EnvGOOS, goos),
-				cmdrunner.Env(gocmd.EnvGOARCH, goarch),
-			)),
-		}
-
-		if err := gocmd.BuildPath(ctx, out, binary, mainPath, buildFlags, buildOptions...); err != nil {
-			return "", err
-		}
+	fmt.Fprintln(c.stdLog().out, "📦 Installing dependencies...")
 
-		tarr, err := archive.Tar(out, archive.Gzip)
-		if err != nil {
-			return "", err
-		}
+	// We do mod tidy before checking for checksum changes, because go.mod gets modified often
+	// and the mod verify command is the expensive one anyway
+	if err := gocmd.ModTidy(ctx, c.app.Path); err != nil {
+		return nil, nil, err
+	}
 
-		tarName := fmt.Sprintf("%s_%s_%s.tar.gz", prefix, goos, goarch)
-		tarPath := filepath.Join(releasePath, tarName)
+	dirCache := cache.New[[]byte](cacheStorage, buildDirchangeCacheNamespace)
+	modChanged, err := dirchange.HasDirChecksumChanged(dirCache, modChecksumKey, c.app.Path, "go.mod")
+	if err != nil {
+		return nil, nil, err
+	}
 
-		tarf, err := os.Create(tarPath)
-		if err != nil {
-			return "", err
+	if modChanged {
+		// By default no dependencies are checked to avoid issues with module
+		// ziphash files in case a Go workspace is being used.
+		if c.options.checkDependencies {
+			if err := gocmd.ModVerify(ctx, c.app.Path); err != nil {
+				return nil, nil, err
+			}
 		}
-		defer tarf.Close()
 
-		if _, err := io.Copy(tarf, tarr); err != nil {
-			return "", err
+		if err := dirchange.SaveDirChecksum(dirCache, modChecksumKey, c.app.Path, "go.mod"); err != nil {
+			return nil, nil, err
 		}
-		tarf.Close()
 	}
 
-	checksumPath := filepath.Join(releasePath, releaseChecksumKey)
+	fmt.Fprintln(c.stdLog().out, "🛠️  Building the blockchain...")
 
-	// create a checksum.txt and return with the path to release dir.
-	return releasePath, checksum.Sum(releasePath, checksumPath)
+	return buildFlags, buildEnv, nil
 }
 
-func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (buildFlags []string, err error) {
-	config, err := c.Config()
-	if err != nil {
-		return nil, err
-	}
-
-	chainID, err := c.ID()
-	if err != nil {
-		return nil, err
-	}
-
-	ldFlags := config.Build.LDFlags
-	ldFlags = append(ldFlags,
+// ldFlags returns the linker flags used to build the binary.
+func (c *Chain) ldFlags(conf *chainconfig.Config, chainID string) []string {
+	ldFlags := append([]string{}, conf.Build.LDFlags...)
+	return append(ldFlags,
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Name=%s", xstrings.Title(c.app.Name)),
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.AppName=%sd", c.app.Name),
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Version=%s", c.sourceVersion.tag),
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Commit=%s", c.sourceVersion.hash),
 		fmt.Sprintf("-X %s/cmd/%s/cmd.ChainID=%s", c.app.ImportPath, c.app.D(), chainID),
 	)
-	buildFlags = []string{
+}
+
+// goBuildFlags returns the flags of the go build command for the build config.
+func goBuildFlags(build config.Build, ldFlags []string) []string {
+	flags := []string{
 		gocmd.FlagMod, gocmd.FlagModValueReadOnly,
 		gocmd.FlagLdflags, gocmd.Ldflags(ldFlags...),
 	}
 
-	fmt.Fprintln(c.stdLog().out, "📦 Installing dependencies...")
+	if len(build.Tags) > 0 {
+		flags = append(flags, gocmd.FlagTags, gocmd.Tags(build.Tags...))
+	}
 
-	// We do mod tidy before checking for checksum changes, because go.mod gets modified often
-	// and the mod verify command is the expensive one anyway
-	if err := gocmd.ModTidy(ctx, c.app.Path); err != nil {
-		return nil, err
+	for _, f := range joinFlagsByPattern(build.GCFlags) {
+		flags = append(flags, gocmd.FlagGcflags, f)
 	}
 
-	dirCache := cache.New[[]byte](cacheStorage, buildDirchangeCacheNamespace)
-	modChanged, err := dirchange.HasDirChecksumChanged(dirCache, modChecksumKey, c.app.Path, "go.mod")
-	if err != nil {
-		return nil, err
+	for _, f := range joinFlagsByPattern(build.ASMFlags) {
+		flags = append(flags, gocmd.FlagAsmflags, f)
 	}
 
-	if modChanged {
-		// By default no dependencies are checked to avoid issues with module
-		// ziphash files in case a Go workspace is being used.
-		if c.options.checkDependencies {
-			if err := gocmd.ModVerify(ctx, c.app.Path); err != nil {
-				return nil, err
+	if build.TrimPath {
+		flags = append(flags, gocmd.FlagTrimpath)
+	}
+
+	return flags
+}
+
+// joinFlagsByPattern joins the arguments of the -gcflags and -asmflags flags that have the same
+// package pattern, e.g. "all=-N" and "all=-l" are joined in "all=-N -l", because the go command
+// only uses the last flag of each pattern. The arguments without a pattern start with a dash.
+func joinFlagsByPattern(values []string) []string {
+	var (
+		patterns []string
+		args     = make(map[string][]string)
+	)
+
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		var pattern string
+		if !strings.HasPrefix(v, "-") {
+			if i := strings.Index(v, "="); i >= 0 {
+				pattern, v = v[:i], v[i+1:]
 			}
 		}
 
-		if err := dirchange.SaveDirChecksum(dirCache, modChecksumKey, c.app.Path, "go.mod"); err != nil {
-			return nil, err
+		if _, ok := args[pattern]; !ok {
+			patterns = append(patterns, pattern)
 		}
+
+		args[pattern] = append(args[pattern], v)
 	}
 
-	fmt.Fprintln(c.stdLog().out, "🛠️  Building the blockchain...")
+	flags := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		joined := strings.Join(args[pattern], " ")
+		if pattern != "" {
+			joined = pattern + "=" + joined
+		}
+
+		flags = append(flags, joined)
+	}
+
+	return flags
+}
+
+// goBuildEnv returns the environment variables of the go build command for the build config.
+func goBuildEnv(build config.Build) []string {
+	var env []string
+
+	if build.CGO != nil {
+		cgoEnabled := "0"
+		if *build.CGO {
+			cgoEnabled = "1"
+		}
+
+		env = append(env, cmdrunner.Env(gocmd.EnvCGOEnabled, cgoEnabled))
+	}
+
+	// Sort the variables to always build with the same environment
+	names := make([]string, 0, len(build.Env))
+	for name := range build.Env {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		env = append(env, cmdrunner.Env(name, build.Env[name]))
+	}
 
-	return buildFlags, nil
+	return env
 }
 
 func (c *Chain) discoverMain(path string) (pkgPath string, err error) {
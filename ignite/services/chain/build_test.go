@@ -0,0 +1,90 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig/config"
+)
+
+func TestGoBuildFlags(t *testing.T) {
+	tests := []struct {
+		name  string
+		build config.Build
+		want  []string
+	}{
+		{
+			name: "default",
+			want: []string{"-mod", "readonly", "-ldflags", "-X a=b"},
+		},
+		{
+			name: "customized",
+			build: config.Build{
+				Tags:     []string{"ledger", "netgo"},
+				GCFlags:  []string{"all=-N -l"},
+				ASMFlags: []string{"all=-trimpath=/src", "std=-S"},
+				TrimPath: true,
+			},
+			want: []string{
+				"-mod", "readonly",
+				"-ldflags", "-X a=b",
+				"-tags", "ledger,netgo",
+				"-gcflags", "all=-N -l",
+				"-asmflags", "all=-trimpath=/src",
+				"-asmflags", "std=-S",
+				"-trimpath",
+			},
+		},
+		{
+			name: "same package pattern",
+			build: config.Build{
+				GCFlags:  []string{"-N", "-l", "all=-N", "std=-S", "all=-l"},
+				ASMFlags: []string{"all=-trimpath=/src", "all=-S"},
+			},
+			want: []string{
+				"-mod", "readonly",
+				"-ldflags", "-X a=b",
+				"-gcflags", "-N -l",
+				"-gcflags", "all=-N -l",
+				"-gcflags", "std=-S",
+				"-asmflags", "all=-trimpath=/src -S",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, goBuildFlags(tt.build, []string{"-X a=b"}))
+		})
+	}
+}
+
+func TestGoBuildEnv(t *testing.T) {
+	cgo := false
+
+	tests := []struct {
+		name  string
+		build config.Build
+		want  []string
+	}{
+		{
+			name: "default",
+		},
+		{
+			name: "customized",
+			build: config.Build{
+				CGO: &cgo,
+				Env: map[string]string{
+					"LD_LIBRARY_PATH": "/opt/wasmvm",
+					"CC":              "musl-gcc",
+				},
+			},
+			want: []string{"CGO_ENABLED=0", "CC=musl-gcc", "LD_LIBRARY_PATH=/opt/wasmvm"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, goBuildEnv(tt.build))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/gookit/color"
@@ -17,8 +18,8 @@ import (
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/confile"
 	"github.com/ignite/cli/ignite/pkg/cosmosver"
+	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/repoversion"
-	"github.com/ignite/cli/ignite/pkg/xurl"
 )
 
 var (
@@ -37,6 +38,7 @@ var (
 type version struct {
 	tag  string
 	hash string
+	time time.Time
 }
 
 type LogLvl int
@@ -63,6 +65,18 @@ type Chain struct {
 	serveRefresher chan struct{}
 	served         bool
 
+	// control holds the state of the serve session managed by the control API.
+	control *serveControl
+
+	// addressOverrides holds the server addresses that replace the configured ones.
+	addressOverrides *addressOverrides
+
+	// nodeLogs configures the logs of the nodes when the chain is served.
+	nodeLogs nodeLogOptions
+
+	// ev is the bus where lifecycle events are sent.
+	ev events.LifecycleBus
+
 	// protoBuiltAtLeastOnce indicates that app's proto generation at least made once.
 	protoBuiltAtLeastOnce bool
 
@@ -90,6 +104,9 @@ type chainOptions struct {
 
 	// path of a custom config file
 	ConfigFile string
+
+	// name of the config profile that overrides the values of the config file
+	configProfile string
 }
 
 // Option configures Chain.
@@ -130,6 +147,15 @@ func ConfigFile(configFile string) Option {
 	}
 }
 
+// ConfigProfile specifies a config profile to override the values of the config file.
+// The values of the profile are defined in a file next to the config file, e.g. config.ci.yml
+// for the "ci" profile.
+func ConfigProfile(profile string) Option {
+	return func(c *Chain) {
+		c.options.configProfile = profile
+	}
+}
+
 // EnableThirdPartyModuleCodegen enables code generation for third party modules,
 // including the SDK.
 func EnableThirdPartyModuleCodegen() Option {
@@ -147,6 +173,13 @@ func CheckDependencies() Option {
 	}
 }
 
+// CollectEvents sends the lifecycle events of the chain to a bus.
+func CollectEvents(ev events.LifecycleBus) Option {
+	return func(c *Chain) {
+		c.ev = ev
+	}
+}
+
 // New initializes a new Chain with options that its source lives at path.
 func New(path string, options ...Option) (*Chain, error) {
 	app, err := NewAppAt(path)
@@ -155,11 +188,13 @@ func New(path string, options ...Option) (*Chain, error) {
 	}
 
 	c := &Chain{
-		app:            app,
-		logLevel:       LogSilent,
-		serveRefresher: make(chan struct{}, 1),
-		stdout:         io.Discard,
-		stderr:         io.Discard,
+		app:              app,
+		logLevel:         LogSilent,
+		serveRefresher:   make(chan struct{}, 1),
+		control:          &serveControl{},
+		addressOverrides: &addressOverrides{},
+		stdout:           io.Discard,
+		stderr:           io.Discard,
 	}
 
 	// Apply the options
@@ -200,6 +235,7 @@ func (c *Chain) appVersion() (v version, err error) {
 
 	v.hash = ver.Hash
 	v.tag = ver.Tag
+	v.time = ver.Time
 
 	return v, nil
 }
@@ -236,13 +272,44 @@ func (c *Chain) ConfigPath() string {
 	return path
 }
 
+// ConfigProfilePath returns the path of the config file of the profile used by the chain
+// Empty string means that the chain uses no profile
+func (c *Chain) ConfigProfilePath() string {
+	configPath := c.ConfigPath()
+	if c.options.configProfile == "" || configPath == "" {
+		return ""
+	}
+	return chainconfig.ProfilePath(configPath, c.options.configProfile)
+}
+
+// configPaths returns the paths of the config files of the chain.
+func (c *Chain) configPaths() []string {
+	var paths []string
+	if configPath := c.ConfigPath(); configPath != "" {
+		paths = append(paths, configPath)
+	}
+	if profilePath := c.ConfigProfilePath(); profilePath != "" {
+		paths = append(paths, profilePath)
+	}
+	return paths
+}
+
 // Config returns the config of the chain
 func (c *Chain) Config() (*chainconfig.Config, error) {
-	configPath := c.ConfigPath()
-	if configPath == "" {
-		return chainconfig.DefaultConfig(), nil
+	conf := chainconfig.DefaultConfig()
+	if configPaths := c.configPaths(); len(configPaths) > 0 {
+		var err error
+		if conf, err = chainconfig.ParseFiles(configPaths...); err != nil {
+			return nil, err
+		}
 	}
-	return chainconfig.ParseFile(configPath)
+
+	// server addresses with ports in use are replaced when the chain is served
+	if err := c.addressOverrides.apply(conf); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
 }
 
 // ID returns the chain's id.
@@ -433,60 +500,16 @@ func (c *Chain) KeyringBackend() (chaincmd.KeyringBackend, error) {
 
 // Commands returns the runner execute commands on the chain's binary
 func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
-	id, err := c.ID()
-	if err != nil {
-		return chaincmdrunner.Runner{}, err
-	}
-
-	home, err := c.Home()
-	if err != nil {
-		return chaincmdrunner.Runner{}, err
-	}
-
-	binary, err := c.Binary()
-	if err != nil {
-		return chaincmdrunner.Runner{}, err
-	}
-
-	backend, err := c.KeyringBackend()
-	if err != nil {
-		return chaincmdrunner.Runner{}, err
-	}
-
 	config, err := c.Config()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
 
-	validator := config.Validators[0]
-	servers, err := validator.GetServers()
-	if err != nil {
-		return chaincmdrunner.Runner{}, err
-	}
-
-	nodeAddr, err := xurl.TCP(servers.RPC.Address)
+	nodes, err := c.nodes(config)
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
 
-	chainCommandOptions := []chaincmd.Option{
-		chaincmd.WithChainID(id),
-		chaincmd.WithHome(home),
-		chaincmd.WithVersion(c.Version),
-		chaincmd.WithNodeAddress(nodeAddr),
-		chaincmd.WithKeyringBackend(backend),
-	}
-
-	cc := chaincmd.New(binary, chainCommandOptions...)
-
-	ccrOptions := make([]chaincmdrunner.Option, 0)
-	if c.logLevel == LogVerbose {
-		ccrOptions = append(ccrOptions,
-			chaincmdrunner.Stdout(os.Stdout),
-			chaincmdrunner.Stderr(os.Stderr),
-			chaincmdrunner.DaemonLogPrefix(c.genPrefix(logAppd)),
-		)
-	}
-
-	return chaincmdrunner.New(ctx, cc, ccrOptions...)
+	// The commands are executed on the node of the first validator
+	return c.nodeCommands(ctx, nodes[0])
 }
@@ -0,0 +1,86 @@
+package chain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// localClient returns a client connected to the node of the first validator
+// that signs the transactions with the accounts of the chain's keyring.
+func (c *Chain) localClient(
+	ctx context.Context,
+	conf *chainconfig.Config,
+	addressPrefix string,
+	options ...cosmosclient.Option,
+) (cosmosclient.Client, error) {
+	servers, err := conf.Validators[0].GetServers()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	rpcAddr, err := xurl.HTTP(servers.RPC.Address)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	return c.client(ctx, rpcAddr, addressPrefix, options...)
+}
+
+// client returns a client connected to a node that signs the transactions with the accounts of the chain's keyring.
+func (c *Chain) client(
+	ctx context.Context,
+	nodeAddress,
+	addressPrefix string,
+	options ...cosmosclient.Option,
+) (cosmosclient.Client, error) {
+	home, err := c.Home()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	options = append([]cosmosclient.Option{
+		cosmosclient.WithNodeAddress(nodeAddress),
+		cosmosclient.WithHome(home),
+		cosmosclient.WithAddressPrefix(addressPrefix),
+		cosmosclient.WithGas("auto"),
+	}, options...)
+
+	if backend != "" {
+		options = append(options, cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(backend)))
+	}
+
+	return cosmosclient.New(ctx, options...)
+}
+
+// addressPrefix returns the address prefix of the chain.
+// The prefix is read from the address of the first account defined in the config.
+func (c *Chain) addressPrefix(ctx context.Context, commands chaincmdrunner.Runner, conf *chainconfig.Config) (string, error) {
+	if len(conf.Accounts) == 0 {
+		return "", errors.New("the config has no accounts to read the address prefix from")
+	}
+
+	address := conf.Accounts[0].Address
+	if address == "" {
+		acc, err := commands.ShowAccount(ctx, conf.Accounts[0].Name)
+		if err != nil {
+			return "", err
+		}
+
+		address = acc.Address
+	}
+
+	return cosmosutil.GetAddressPrefix(address)
+}
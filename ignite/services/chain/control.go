@@ -0,0 +1,297 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/dirchange"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+	"github.com/ignite/cli/ignite/pkg/xnet"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// ErrChainNotRunning is returned by the control API when an action requires the chain to be running.
+var ErrChainNotRunning = errors.New("the chain is not running")
+
+// serveControl holds the actions requested to a running serve session
+// through the control API and the status reported by the session.
+type serveControl struct {
+	mu sync.Mutex
+
+	// resetOnce requests to reset the state of the chain on the next restart.
+	resetOnce bool
+
+	// forceBuild requests to build the chain on the next restart.
+	forceBuild bool
+
+	// running indicates that the chain nodes are started.
+	running bool
+
+	// lastBuildErr is the error of the last build that failed.
+	lastBuildErr error
+
+	// exportWaiters are notified when the genesis state is exported.
+	exportWaiters []chan exportResult
+}
+
+type exportResult struct {
+	path string
+	err  error
+}
+
+// takeRequests returns the pending reset and build requests and clears them.
+func (s *serveControl) takeRequests() (resetOnce, forceBuild bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resetOnce, forceBuild = s.resetOnce, s.forceBuild
+	s.resetOnce, s.forceBuild = false, false
+
+	return resetOnce, forceBuild
+}
+
+func (s *serveControl) requestReset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resetOnce = true
+}
+
+func (s *serveControl) requestBuild() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.forceBuild = true
+}
+
+func (s *serveControl) setRunning(running bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.running = running
+}
+
+func (s *serveControl) isRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.running
+}
+
+func (s *serveControl) setBuildError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastBuildErr = err
+}
+
+func (s *serveControl) buildError() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lastBuildErr
+}
+
+// waitExport returns a channel that receives the result of the next genesis export.
+func (s *serveControl) waitExport() <-chan exportResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan exportResult, 1)
+	s.exportWaiters = append(s.exportWaiters, ch)
+
+	return ch
+}
+
+// exported notifies the result of a genesis export to the waiters.
+func (s *serveControl) exported(path string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ch := range s.exportWaiters {
+		ch <- exportResult{path, err}
+	}
+
+	s.exportWaiters = nil
+}
+
+// serveStatus is the status of a serve session reported by the control API.
+type serveStatus struct {
+	Running        bool                 `json:"running"`
+	BinaryChecksum string               `json:"binary_checksum,omitempty"`
+	LastBuildError string               `json:"last_build_error,omitempty"`
+	FaucetAddress  string               `json:"faucet_address,omitempty"`
+	Validators     []validatorEndpoints `json:"validators"`
+}
+
+// validatorEndpoints are the server addresses of a validator node.
+type validatorEndpoints struct {
+	Name string `json:"name"`
+	RPC  string `json:"rpc"`
+	API  string `json:"api"`
+	GRPC string `json:"grpc"`
+	P2P  string `json:"p2p"`
+}
+
+// runControlServer starts the control API server when it's enabled in the config.
+func (c *Chain) runControlServer(ctx context.Context, conf *chainconfig.Config) error {
+	if conf.Control.Port == 0 {
+		return nil
+	}
+
+	addr := xnet.LocalhostIPv4Address(conf.Control.Port)
+	controlAddr, _ := xurl.HTTP(addr)
+	fmt.Fprintf(c.stdLog().out, "🕹  Control API: %s\n", controlAddr)
+
+	return xhttp.Serve(ctx, &http.Server{
+		Addr:    addr,
+		Handler: c.controlHandler(),
+	})
+}
+
+// controlHandler returns the HTTP handler of the control API.
+func (c *Chain) controlHandler() http.Handler {
+	router := mux.NewRouter()
+
+	router.
+		HandleFunc("/status", c.controlStatusHandler).
+		Methods(http.MethodGet)
+
+	router.
+		HandleFunc("/restart", c.controlRestartHandler).
+		Methods(http.MethodPost)
+
+	router.
+		HandleFunc("/reset", c.controlResetHandler).
+		Methods(http.MethodPost)
+
+	router.
+		HandleFunc("/rebuild", c.controlRebuildHandler).
+		Methods(http.MethodPost)
+
+	router.
+		HandleFunc("/export", c.controlExportHandler).
+		Methods(http.MethodPost)
+
+	return router
+}
+
+func (c *Chain) controlStatusHandler(w http.ResponseWriter, _ *http.Request) {
+	status, err := c.serveStatus()
+	if err != nil {
+		xhttp.ResponseJSON(w, http.StatusInternalServerError, xhttp.NewErrorResponse(err))
+		return
+	}
+
+	xhttp.ResponseJSON(w, http.StatusOK, status)
+}
+
+func (c *Chain) controlRestartHandler(w http.ResponseWriter, _ *http.Request) {
+	c.refreshServe()
+	xhttp.ResponseJSON(w, http.StatusAccepted, struct{}{})
+}
+
+func (c *Chain) controlResetHandler(w http.ResponseWriter, _ *http.Request) {
+	c.control.requestReset()
+	c.refreshServe()
+	xhttp.ResponseJSON(w, http.StatusAccepted, struct{}{})
+}
+
+func (c *Chain) controlRebuildHandler(w http.ResponseWriter, _ *http.Request) {
+	c.control.requestBuild()
+	c.refreshServe()
+	xhttp.ResponseJSON(w, http.StatusAccepted, struct{}{})
+}
+
+// controlExportHandler exports the genesis state by restarting the chain,
+// because the state can only be exported while the nodes are stopped.
+func (c *Chain) controlExportHandler(w http.ResponseWriter, r *http.Request) {
+	if !c.control.isRunning() {
+		xhttp.ResponseJSON(w, http.StatusConflict, xhttp.NewErrorResponse(ErrChainNotRunning))
+		return
+	}
+
+	exported := c.control.waitExport()
+	c.refreshServe()
+
+	select {
+	case <-r.Context().Done():
+		return
+	case res := <-exported:
+		if res.err != nil {
+			xhttp.ResponseJSON(w, http.StatusInternalServerError, xhttp.NewErrorResponse(res.err))
+			return
+		}
+
+		xhttp.ResponseJSON(w, http.StatusOK, struct {
+			Path string `json:"path"`
+		}{res.path})
+	}
+}
+
+// serveStatus returns the current status of the serve session.
+func (c *Chain) serveStatus() (serveStatus, error) {
+	status := serveStatus{
+		Running: c.control.isRunning(),
+	}
+
+	if err := c.control.buildError(); err != nil {
+		status.LastBuildError = err.Error()
+	}
+
+	binaryName, err := c.Binary()
+	if err != nil {
+		return serveStatus{}, err
+	}
+
+	// the binary is not available until the first build succeeds
+	if binaryPath, err := exec.LookPath(binaryName); err == nil {
+		checksum, err := dirchange.ChecksumFromPaths("", binaryPath)
+		if err != nil {
+			return serveStatus{}, err
+		}
+
+		status.BinaryChecksum = fmt.Sprintf("%x", checksum)
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return serveStatus{}, err
+	}
+
+	if conf.Faucet.Name != nil {
+		status.FaucetAddress, _ = xurl.HTTP(chainconfig.FaucetHost(conf))
+	}
+
+	nodes, err := c.nodes(conf)
+	if err != nil {
+		return serveStatus{}, err
+	}
+
+	for _, n := range nodes {
+		servers, err := n.validator.GetServers()
+		if err != nil {
+			return serveStatus{}, err
+		}
+
+		rpcAddr, _ := xurl.HTTP(servers.RPC.Address)
+		apiAddr, _ := xurl.HTTP(servers.API.Address)
+
+		status.Validators = append(status.Validators, validatorEndpoints{
+			Name: n.name,
+			RPC:  rpcAddr,
+			API:  apiAddr,
+			GRPC: servers.GRPC.Address,
+			P2P:  servers.P2P.Address,
+		})
+	}
+
+	return status, nil
+}
@@ -0,0 +1,71 @@
+package chain
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeControlRequests(t *testing.T) {
+	var s serveControl
+
+	resetOnce, forceBuild := s.takeRequests()
+	require.False(t, resetOnce)
+	require.False(t, forceBuild)
+
+	s.requestReset()
+	s.requestBuild()
+
+	resetOnce, forceBuild = s.takeRequests()
+	require.True(t, resetOnce)
+	require.True(t, forceBuild)
+
+	// requests are cleared once they are taken
+	resetOnce, forceBuild = s.takeRequests()
+	require.False(t, resetOnce)
+	require.False(t, forceBuild)
+}
+
+func TestServeControlExported(t *testing.T) {
+	var s serveControl
+
+	exported := s.waitExport()
+	s.exported("genesis.json", nil)
+	require.Equal(t, exportResult{path: "genesis.json"}, <-exported)
+
+	err := errors.New("export failed")
+	exported = s.waitExport()
+	s.exported("", err)
+	require.Equal(t, exportResult{err: err}, <-exported)
+}
+
+func TestControlHandler(t *testing.T) {
+	c := &Chain{
+		control:        &serveControl{},
+		serveRefresher: make(chan struct{}, 1),
+	}
+	h := c.controlHandler()
+
+	// export requires the chain to be running
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/export", nil))
+	require.Equal(t, http.StatusConflict, w.Code)
+
+	// reset requests a restart with a state reset
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/reset", nil))
+	require.Equal(t, http.StatusAccepted, w.Code)
+	require.Len(t, c.serveRefresher, 1)
+
+	resetOnce, forceBuild := c.control.takeRequests()
+	require.True(t, resetOnce)
+	require.False(t, forceBuild)
+
+	// actions only accept POST requests
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/restart", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
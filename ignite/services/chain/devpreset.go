@@ -0,0 +1,185 @@
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+	"github.com/ignite/cli/ignite/pkg/jsonfile"
+)
+
+// applyDevPreset sets the genesis values of the dev preset.
+// The genesis values of the config have priority over the values of the preset.
+func (c *Chain) applyDevPreset(conf *chainconfig.Config, preset chainconfig.DevPreset) error {
+	path, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	g, err := genesis.FromPath(path)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	var options []jsonfile.UpdateFileOption
+	for _, o := range devPresetGenesisOverrides(conf, g, preset) {
+		value, err := json.Marshal(o.Value)
+		if err != nil {
+			return err
+		}
+
+		options = append(options, jsonfile.WithKeyRawValue(o.Path, value))
+	}
+
+	if len(options) == 0 {
+		return nil
+	}
+
+	return g.Update(options...)
+}
+
+// printDevPreset prints the values of the dev preset that are set in the genesis and in the
+// Tendermint config of the nodes. The values are printed each time the chain is started
+// because the state of the chain might have been initialized by a previous serve, only
+// the values that the genesis and the config files really have are printed since the
+// chain might have been initialized with another preset or forked from an exported genesis.
+func (c *Chain) printDevPreset(conf *chainconfig.Config, nodes []node) error {
+	preset, err := chainconfig.ConfigDevPreset(conf)
+	if err != nil {
+		return err
+	}
+
+	path, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	g, err := genesis.FromPath(path)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	for _, o := range devPresetGenesisOverrides(conf, g, preset) {
+		value, err := g.RawField(o.Path)
+		if err != nil {
+			return err
+		}
+
+		set, err := isDevPresetValue(value, o)
+		if err != nil {
+			return err
+		}
+
+		if set {
+			c.printDevPresetOverride(preset, "genesis", o)
+		}
+	}
+
+	// the consensus values are set when the nodes are configured
+	if conf.DevPreset == "" || len(nodes) == 0 {
+		return nil
+	}
+
+	configs := make([]*toml.Tree, len(nodes))
+	for i, n := range nodes {
+		if configs[i], err = toml.LoadFile(filepath.Join(n.home, "config/config.toml")); err != nil {
+			return err
+		}
+	}
+
+	for _, o := range preset.Consensus {
+		set := true
+		for i, n := range nodes {
+			if hasMapPath(n.validator.Config, o.Path) || fmt.Sprint(configs[i].Get(o.Path)) != fmt.Sprint(o.Value) {
+				set = false
+				break
+			}
+		}
+
+		if set {
+			c.printDevPresetOverride(preset, "config.toml", o)
+		}
+	}
+
+	return nil
+}
+
+// isDevPresetValue checks if a JSON encoded genesis value is the value of a dev preset override.
+func isDevPresetValue(value []byte, o chainconfig.DevPresetOverride) (bool, error) {
+	presetValue, err := json.Marshal(o.Value)
+	if err != nil {
+		return false, err
+	}
+
+	var v, pv interface{}
+	if err := json.Unmarshal(value, &v); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(presetValue, &pv); err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(v, pv), nil
+}
+
+// devPresetGenesisOverrides returns the genesis values of the dev preset that are set in the genesis.
+// Only the values that exist in the genesis of the chain and that are not defined in the config are set.
+func devPresetGenesisOverrides(
+	conf *chainconfig.Config,
+	g *genesis.Genesis,
+	preset chainconfig.DevPreset,
+) (overrides []chainconfig.DevPresetOverride) {
+	for _, o := range preset.Genesis {
+		if _, err := g.RawField(o.Path); err != nil {
+			continue
+		}
+
+		if hasMapPath(conf.Genesis, o.Path) {
+			continue
+		}
+
+		overrides = append(overrides, o)
+	}
+
+	return overrides
+}
+
+func (c *Chain) printDevPresetOverride(preset chainconfig.DevPreset, file string, o chainconfig.DevPresetOverride) {
+	fmt.Fprintf(
+		c.stdLog().out,
+		"⚙️  Dev preset %q sets %s %s to %v\n",
+		preset.Name,
+		file,
+		o.Path,
+		o.Value,
+	)
+}
+
+// hasMapPath checks if a map has a value in a path of dot separated keys.
+func hasMapPath(m map[string]interface{}, path string) bool {
+	keys := strings.Split(path, ".")
+	for i, k := range keys {
+		v, ok := m[k]
+		if !ok {
+			return false
+		}
+
+		if i == len(keys)-1 {
+			return true
+		}
+
+		if m, ok = v.(map[string]interface{}); !ok {
+			return false
+		}
+	}
+
+	return false
+}
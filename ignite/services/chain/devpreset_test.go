@@ -0,0 +1,21 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestIsDevPresetValue(t *testing.T) {
+	o := chainconfig.DevPresetOverride{Path: "app_state.gov.voting_params.voting_period", Value: "60s"}
+
+	set, err := isDevPresetValue([]byte(`"60s"`), o)
+	require.NoError(t, err)
+	require.True(t, set)
+
+	set, err = isDevPresetValue([]byte(`"172800s"`), o)
+	require.NoError(t, err)
+	require.False(t, set)
+}
@@ -0,0 +1,36 @@
+package chain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// phaseStarted sends an event to notify that a lifecycle phase started
+// and returns the start time of the phase.
+func (c *Chain) phaseStarted(phase events.Phase, options ...events.LifecycleOption) time.Time {
+	e := events.NewLifecycle(phase, events.PhaseStarted, options...)
+	c.ev.Send(e)
+	return e.Time
+}
+
+// phaseDone sends an event to notify that a lifecycle phase that started at
+// the given time either succeeded or failed depending on the error.
+// Phases stopped by a context cancellation are not considered failed.
+func (c *Chain) phaseDone(phase events.Phase, start time.Time, err error, options ...events.LifecycleOption) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	status := events.PhaseSucceeded
+	if err != nil {
+		status = events.PhaseFailed
+		options = append(options, events.Failure(err))
+	}
+
+	options = append(options, events.Elapsed(start))
+	c.ev.Send(events.NewLifecycle(phase, status, options...))
+}
@@ -0,0 +1,316 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+// Fixtures holds the transactions that are broadcasted to populate
+// the state of the chain after it's initialized.
+type Fixtures struct {
+	Transactions []FixtureTx `json:"transactions"`
+}
+
+// FixtureTx is a transaction signed by one of the accounts defined in the config.
+type FixtureTx struct {
+	// Signer is the name of the account that signs the transaction.
+	Signer string `json:"signer"`
+
+	// Messages are the messages of the transaction.
+	Messages []FixtureMsg `json:"messages"`
+}
+
+// FixtureMsg is a transaction message.
+type FixtureMsg struct {
+	// TypeURL is the protobuf type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend.
+	TypeURL string `json:"type_url"`
+
+	// Body is the message in JSON. The addresses of the accounts defined
+	// in the config can be referenced in the body by their name, e.g. ${alice}.
+	Body json.RawMessage `json:"body"`
+}
+
+// FixtureError is returned when a fixture transaction can't be broadcasted.
+type FixtureError struct {
+	// Index is the position of the transaction in the fixtures file.
+	Index int
+
+	// Signer is the name of the account that signs the transaction.
+	Signer string
+
+	Err error
+}
+
+func (e *FixtureError) Error() string {
+	return fmt.Sprintf("cannot apply fixture transaction #%d signed by %s: %s", e.Index+1, e.Signer, e.Err)
+}
+
+func (e *FixtureError) Unwrap() error {
+	return e.Err
+}
+
+// ParseFixtures parses fixtures from JSON and validates them.
+func ParseFixtures(r io.Reader) (Fixtures, error) {
+	var f Fixtures
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return Fixtures{}, err
+	}
+
+	for i, tx := range f.Transactions {
+		if tx.Signer == "" {
+			return Fixtures{}, fmt.Errorf("fixture transaction #%d has no signer", i+1)
+		}
+
+		if len(tx.Messages) == 0 {
+			return Fixtures{}, fmt.Errorf("fixture transaction #%d has no messages", i+1)
+		}
+
+		for _, msg := range tx.Messages {
+			if !strings.HasPrefix(msg.TypeURL, "/") {
+				return Fixtures{}, fmt.Errorf("fixture transaction #%d has an invalid message type URL: %q", i+1, msg.TypeURL)
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// ExpandAddresses replaces the account names referenced in the message
+// bodies of the transactions with the addresses of the accounts.
+func (f Fixtures) ExpandAddresses(addresses map[string]string) Fixtures {
+	expand := func(name string) string {
+		if addr, ok := addresses[name]; ok {
+			return addr
+		}
+
+		// Keep the references that are not account names
+		return fmt.Sprintf("${%s}", name)
+	}
+
+	expanded := Fixtures{Transactions: make([]FixtureTx, len(f.Transactions))}
+	for i, tx := range f.Transactions {
+		expandedTx := FixtureTx{
+			Signer:   tx.Signer,
+			Messages: make([]FixtureMsg, len(tx.Messages)),
+		}
+
+		for j, msg := range tx.Messages {
+			expandedTx.Messages[j] = FixtureMsg{
+				TypeURL: msg.TypeURL,
+				Body:    json.RawMessage(os.Expand(string(msg.Body), expand)),
+			}
+		}
+
+		expanded.Transactions[i] = expandedTx
+	}
+
+	return expanded
+}
+
+// fixturesPath returns the path of the fixtures file defined in the config.
+func (c *Chain) fixturesPath(conf *chainconfig.Config) string {
+	if conf.Fixtures == "" || filepath.IsAbs(conf.Fixtures) {
+		return conf.Fixtures
+	}
+
+	return filepath.Join(c.app.Path, conf.Fixtures)
+}
+
+// applyFixtures broadcasts the transactions of the fixtures file defined in the config.
+// The transactions are broadcasted in order once the chain is producing blocks.
+func (c *Chain) applyFixtures(ctx context.Context, conf *chainconfig.Config) error {
+	file, err := os.Open(c.fixturesPath(conf))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fixtures, err := ParseFixtures(file)
+	if err != nil {
+		return errors.Wrapf(err, "invalid fixtures file %s", conf.Fixtures)
+	}
+
+	if len(fixtures.Transactions) == 0 {
+		return nil
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	addresses, err := c.accountAddresses(ctx, commands, conf)
+	if err != nil {
+		return err
+	}
+
+	for i, tx := range fixtures.Transactions {
+		if _, ok := addresses[tx.Signer]; !ok {
+			return &FixtureError{i, tx.Signer, errors.New("the signer is not an account defined in the config")}
+		}
+	}
+
+	fixtures = fixtures.ExpandAddresses(addresses)
+
+	txMsgs, err := encodeFixtures(ctx, commands, fixtures)
+	if err != nil {
+		return err
+	}
+
+	prefix, err := cosmosutil.GetAddressPrefix(addresses[fixtures.Transactions[0].Signer])
+	if err != nil {
+		return err
+	}
+
+	client, err := c.localClient(ctx, conf, prefix)
+	if err != nil {
+		return err
+	}
+
+	// the first block must be committed before any transaction can be delivered
+	if err := client.WaitForNextBlock(ctx); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.stdLog().out, "🌱 Applying %d fixture transactions...\n", len(fixtures.Transactions))
+
+	for i, tx := range fixtures.Transactions {
+		account, err := client.Account(tx.Signer)
+		if err != nil {
+			return &FixtureError{i, tx.Signer, err}
+		}
+
+		if _, err := client.BroadcastTx(ctx, account, txMsgs[i]...); err != nil {
+			return &FixtureError{i, tx.Signer, err}
+		}
+	}
+
+	return nil
+}
+
+// accountAddresses returns the addresses of the accounts defined in the config by account name.
+func (c *Chain) accountAddresses(
+	ctx context.Context,
+	commands chaincmdrunner.Runner,
+	conf *chainconfig.Config,
+) (map[string]string, error) {
+	addresses := make(map[string]string)
+	for _, account := range conf.Accounts {
+		if account.Address != "" {
+			addresses[account.Name] = account.Address
+			continue
+		}
+
+		acc, err := commands.ShowAccount(ctx, account.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses[account.Name] = acc.Address
+	}
+
+	return addresses, nil
+}
+
+// encodeFixtures encodes the messages of the fixture transactions in protobuf.
+// The messages are encoded by the chain binary because the client doesn't know
+// the Go types of the messages that belong to the custom modules of the chain.
+// All the messages are encoded at once within a single transaction and then
+// they are split again by fixture transaction.
+func encodeFixtures(ctx context.Context, commands chaincmdrunner.Runner, f Fixtures) ([][]sdktypes.Msg, error) {
+	var msgs []json.RawMessage
+	for i, tx := range f.Transactions {
+		for _, msg := range tx.Messages {
+			var body map[string]json.RawMessage
+			if err := json.Unmarshal(msg.Body, &body); err != nil {
+				return nil, errors.Wrapf(err, "invalid body of a %s message in fixture transaction #%d", msg.TypeURL, i+1)
+			}
+
+			if body == nil {
+				body = make(map[string]json.RawMessage)
+			}
+
+			typeURL, err := json.Marshal(msg.TypeURL)
+			if err != nil {
+				return nil, err
+			}
+
+			body["@type"] = typeURL
+
+			bz, err := json.Marshal(body)
+			if err != nil {
+				return nil, err
+			}
+
+			msgs = append(msgs, bz)
+		}
+	}
+
+	txJSON, err := json.Marshal(map[string]interface{}{
+		"body": map[string]interface{}{
+			"messages": msgs,
+		},
+		"auth_info":  map[string]interface{}{},
+		"signatures": []string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txFile, err := os.CreateTemp("", "fixtures-*.json")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(txFile.Name())
+
+	_, err = txFile.Write(txJSON)
+	txFile.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := commands.EncodeTx(ctx, txFile.Name())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot encode the fixture messages")
+	}
+
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(txBytes); err != nil {
+		return nil, err
+	}
+
+	var body txtypes.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return nil, err
+	}
+
+	if len(body.Messages) != len(msgs) {
+		return nil, fmt.Errorf("expected %d encoded fixture messages, got %d", len(msgs), len(body.Messages))
+	}
+
+	txMsgs := make([][]sdktypes.Msg, len(f.Transactions))
+	for i, tx := range f.Transactions {
+		for range tx.Messages {
+			msg := body.Messages[0]
+			body.Messages = body.Messages[1:]
+
+			txMsgs[i] = append(txMsgs[i], cosmosclient.NewRawMsg(msg.TypeUrl, msg.Value))
+		}
+	}
+
+	return txMsgs, nil
+}
@@ -0,0 +1,81 @@
+package chain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFixtures(t *testing.T) {
+	cases := []struct {
+		name string
+		json string
+		err  string
+	}{
+		{
+			name: "valid fixtures",
+			json: `{"transactions": [{"signer": "alice", "messages": [{"type_url": "/cosmos.bank.v1beta1.MsgSend", "body": {}}]}]}`,
+		},
+		{
+			name: "no transactions",
+			json: `{}`,
+		},
+		{
+			name: "missing signer",
+			json: `{"transactions": [{"messages": [{"type_url": "/cosmos.bank.v1beta1.MsgSend"}]}]}`,
+			err:  "fixture transaction #1 has no signer",
+		},
+		{
+			name: "missing messages",
+			json: `{"transactions": [{"signer": "alice"}]}`,
+			err:  "fixture transaction #1 has no messages",
+		},
+		{
+			name: "invalid type URL",
+			json: `{"transactions": [{"signer": "alice", "messages": [{"type_url": "cosmos.bank.v1beta1.MsgSend"}]}]}`,
+			err:  `fixture transaction #1 has an invalid message type URL: "cosmos.bank.v1beta1.MsgSend"`,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFixtures(strings.NewReader(tt.json))
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFixturesExpandAddresses(t *testing.T) {
+	fixtures := Fixtures{
+		Transactions: []FixtureTx{
+			{
+				Signer: "alice",
+				Messages: []FixtureMsg{
+					{
+						TypeURL: "/cosmos.bank.v1beta1.MsgSend",
+						Body:    []byte(`{"from_address":"${alice}","to_address":"${bob}","memo":"${unknown}"}`),
+					},
+				},
+			},
+		},
+	}
+
+	expanded := fixtures.ExpandAddresses(map[string]string{
+		"alice": "cosmos1alice",
+		"bob":   "cosmos1bob",
+	})
+
+	require.JSONEq(t,
+		`{"from_address":"cosmos1alice","to_address":"cosmos1bob","memo":"${unknown}"}`,
+		string(expanded.Transactions[0].Messages[0].Body),
+	)
+
+	// the original fixtures are not modified
+	require.Contains(t, string(fixtures.Transactions[0].Messages[0].Body), "${alice}")
+}
@@ -0,0 +1,731 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	// privValidatorKeyFile is the node file with the consensus key of the validator
+	privValidatorKeyFile = "config/priv_validator_key.json"
+
+	bondedPoolName    = "bonded_tokens_pool"
+	notBondedPoolName = "not_bonded_tokens_pool"
+
+	bondStatusBonded   = "BOND_STATUS_BONDED"
+	bondStatusUnbonded = "BOND_STATUS_UNBONDED"
+
+	// powerReduction is the amount of staking tokens of a unit of voting power.
+	powerReduction = 1000000
+
+	// decFractional is the fractional part of an integer SDK decimal.
+	decFractional = ".000000000000000000"
+
+	zeroTime = "1970-01-01T00:00:00Z"
+)
+
+type forkOptions struct {
+	fundAccounts bool
+}
+
+// ForkOption configures how the state of another network is forked.
+type ForkOption func(*forkOptions)
+
+// ForkFundAccounts adds the balances of the accounts defined in the config to the forked state.
+func ForkFundAccounts() ForkOption {
+	return func(o *forkOptions) {
+		o.fundAccounts = true
+	}
+}
+
+// forkValidator is the local validator that holds the voting power of a forked network.
+type forkValidator struct {
+	operatorAddress   string
+	delegatorAddress  string
+	consensusAddress  string
+	consensusPubKey   interface{}
+	tendermintAddress string
+	tendermintPubKey  interface{}
+	moniker           string
+	description       interface{}
+	commissionRates   interface{}
+	minSelfDelegation string
+	tokens            *big.Int
+}
+
+// Fork initializes the chain with the state exported from another network, e.g. to test upgrades
+// with the state of a mainnet. The chain is initialized as usual and its genesis is replaced with
+// the exported one, where the staking, slashing and distribution state and the Tendermint validator
+// set are rewritten so the validator from the config holds all the voting power. The validators of
+// the exported network are jailed. The chain ID of the exported genesis is replaced by the chain's ID.
+func (c *Chain) Fork(ctx context.Context, exportedGenesisPath string, options ...ForkOption) (err error) {
+	var o forkOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return &CannotBuildAppError{err}
+	}
+
+	if len(conf.Validators) != 1 {
+		return errors.New("forking a network requires a single validator in the config")
+	}
+
+	// read the exported genesis before initializing to not reset the chain when it's invalid
+	exported, err := readGenesis(exportedGenesisPath)
+	if err != nil {
+		return errors.Wrap(err, "cannot read the exported genesis")
+	}
+
+	if err := c.Init(ctx, true); err != nil {
+		return err
+	}
+
+	start := c.phaseStarted(events.PhaseRestore, events.Message(exportedGenesisPath))
+	defer func() { c.phaseDone(events.PhaseRestore, start, err, events.Message(exportedGenesisPath)) }()
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	genesis, err := readGenesis(genesisPath)
+	if err != nil {
+		return err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	key, err := os.ReadFile(filepath.Join(home, privValidatorKeyFile))
+	if err != nil {
+		return err
+	}
+
+	v, err := newForkValidator(genesis, key)
+	if err != nil {
+		return err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+
+	if err := forkGenesis(exported, chainID, v); err != nil {
+		return errors.Wrap(err, "cannot fork the exported genesis")
+	}
+
+	if o.fundAccounts {
+		if err := fundForkAccounts(exported, genesis); err != nil {
+			return errors.Wrap(err, "cannot fund the accounts")
+		}
+	}
+
+	fmt.Fprintf(c.stdLog().out, "🍴 Forked the state of the exported genesis with validator %q\n", v.operatorAddress)
+
+	if err := writeGenesis(genesisPath, exported); err != nil {
+		return err
+	}
+
+	// the dev preset applied by the initialization is set again in the forked genesis
+	preset, err := chainconfig.ConfigDevPreset(conf)
+	if err != nil {
+		return err
+	}
+
+	return c.applyDevPreset(conf, preset)
+}
+
+// newForkValidator returns the validator created by the gentx of an initialized genesis.
+// key is the content of the file with the consensus key of the validator's node.
+func newForkValidator(genesis map[string]interface{}, key []byte) (forkValidator, error) {
+	genutil, err := genesisObject(genesis, "app_state.genutil")
+	if err != nil {
+		return forkValidator{}, err
+	}
+
+	gentxs := genesisList(genutil, "gen_txs")
+	if len(gentxs) != 1 {
+		return forkValidator{}, errors.New("the initialized genesis must contain a single gentx")
+	}
+
+	gentx, _ := gentxs[0].(map[string]interface{})
+	body, err := genesisObject(gentx, "body")
+	if err != nil {
+		return forkValidator{}, err
+	}
+
+	msgs := genesisList(body, "messages")
+	if len(msgs) != 1 {
+		return forkValidator{}, errors.New("the gentx must contain a single message")
+	}
+
+	msg, _ := msgs[0].(map[string]interface{})
+
+	var privValidatorKey struct {
+		Address string      `json:"address"`
+		PubKey  interface{} `json:"pub_key"`
+	}
+	if err := json.Unmarshal(key, &privValidatorKey); err != nil {
+		return forkValidator{}, err
+	}
+
+	v := forkValidator{
+		operatorAddress:   genesisString(msg, "validator_address"),
+		delegatorAddress:  genesisString(msg, "delegator_address"),
+		consensusPubKey:   msg["pubkey"],
+		tendermintAddress: privValidatorKey.Address,
+		tendermintPubKey:  privValidatorKey.PubKey,
+		description:       msg["description"],
+		commissionRates:   msg["commission"],
+		minSelfDelegation: genesisString(msg, "min_self_delegation"),
+	}
+
+	if description, ok := msg["description"].(map[string]interface{}); ok {
+		v.moniker = genesisString(description, "moniker")
+	}
+
+	value, err := genesisObject(msg, "value")
+	if err != nil {
+		return forkValidator{}, err
+	}
+
+	if v.tokens, err = parseGenesisInt(value["amount"]); err != nil {
+		return forkValidator{}, err
+	}
+
+	prefix, _, err := bech32.DecodeAndConvert(v.operatorAddress)
+	if err != nil {
+		return forkValidator{}, err
+	}
+
+	consAddress, err := hex.DecodeString(v.tendermintAddress)
+	if err != nil {
+		return forkValidator{}, err
+	}
+
+	v.consensusAddress, err = bech32.ConvertAndEncode(strings.TrimSuffix(prefix, "valoper")+"valcons", consAddress)
+	if err != nil {
+		return forkValidator{}, err
+	}
+
+	return v, nil
+}
+
+// forkGenesis rewrites an exported genesis so the validator holds all the voting power of the chain.
+// The tokens of the validator are minted in the staking bond denom of the exported network and the
+// tokens of the bonded validators of the exported network are moved to the not bonded pool.
+func forkGenesis(genesis map[string]interface{}, chainID string, v forkValidator) error {
+	power := new(big.Int).Quo(v.tokens, big.NewInt(powerReduction))
+	if power.Sign() <= 0 {
+		return fmt.Errorf("the validator must bond at least %d tokens to have voting power", powerReduction)
+	}
+
+	genesis["chain_id"] = chainID
+	genesis["validators"] = []interface{}{
+		map[string]interface{}{
+			"address": v.tendermintAddress,
+			"pub_key": v.tendermintPubKey,
+			"power":   power.String(),
+			"name":    v.moniker,
+		},
+	}
+
+	genutil, err := genesisObject(genesis, "app_state.genutil")
+	if err != nil {
+		return err
+	}
+
+	// the validator is created with the forked state, not with a gentx
+	genutil["gen_txs"] = []interface{}{}
+
+	bondDenom, err := forkStaking(genesis, v, power)
+	if err != nil {
+		return err
+	}
+
+	if err := forkBank(genesis, bondDenom, v.tokens); err != nil {
+		return err
+	}
+
+	if err := forkSlashing(genesis, v); err != nil {
+		return err
+	}
+
+	return forkDistribution(genesis, v)
+}
+
+// forkStaking adds the validator with its self delegation as the only bonded validator
+// and jails the rest of the validators. The staking bond denom is returned.
+func forkStaking(genesis map[string]interface{}, v forkValidator, power *big.Int) (bondDenom string, err error) {
+	staking, err := genesisObject(genesis, "app_state.staking")
+	if err != nil {
+		return "", err
+	}
+
+	params, err := genesisObject(staking, "params")
+	if err != nil {
+		return "", err
+	}
+
+	if bondDenom = genesisString(params, "bond_denom"); bondDenom == "" {
+		return "", errors.New("the staking bond denom is missing")
+	}
+
+	updateTime := genesisString(genesis, "genesis_time")
+	if updateTime == "" {
+		updateTime = zeroTime
+	}
+
+	validators := filterGenesisList(genesisList(staking, "validators"), func(val map[string]interface{}) bool {
+		return genesisString(val, "operator_address") != v.operatorAddress
+	})
+
+	// the validators of the exported network can't be unjailed without their keys,
+	// which keeps them out of the validator set
+	for _, val := range validators {
+		if val, ok := val.(map[string]interface{}); ok {
+			val["jailed"] = true
+			val["status"] = bondStatusUnbonded
+		}
+	}
+
+	staking["validators"] = append(validators, map[string]interface{}{
+		"operator_address": v.operatorAddress,
+		"consensus_pubkey": v.consensusPubKey,
+		"jailed":           false,
+		"status":           bondStatusBonded,
+		"tokens":           v.tokens.String(),
+		"delegator_shares": v.tokens.String() + decFractional,
+		"description":      v.description,
+		"unbonding_height": "0",
+		"unbonding_time":   zeroTime,
+		"commission": map[string]interface{}{
+			"commission_rates": v.commissionRates,
+			"update_time":      updateTime,
+		},
+		"min_self_delegation": v.minSelfDelegation,
+	})
+
+	staking["delegations"] = append(
+		filterGenesisList(genesisList(staking, "delegations"), func(d map[string]interface{}) bool {
+			return genesisString(d, "validator_address") != v.operatorAddress
+		}),
+		map[string]interface{}{
+			"delegator_address": v.delegatorAddress,
+			"validator_address": v.operatorAddress,
+			"shares":            v.tokens.String() + decFractional,
+		},
+	)
+
+	staking["last_total_power"] = power.String()
+	staking["last_validator_powers"] = []interface{}{
+		map[string]interface{}{
+			"address": v.operatorAddress,
+			"power":   power.String(),
+		},
+	}
+	staking["exported"] = true
+
+	return bondDenom, nil
+}
+
+// forkBank moves the tokens of the bonded pool to the not bonded pool and mints the tokens of the validator
+// in the bonded pool, so the balances of the pools match the tokens of the validators.
+func forkBank(genesis map[string]interface{}, bondDenom string, tokens *big.Int) error {
+	moduleAddresses, err := genesisModuleAddresses(genesis)
+	if err != nil {
+		return err
+	}
+
+	bonded, notBonded := moduleAddresses[bondedPoolName], moduleAddresses[notBondedPoolName]
+	if bonded == "" || notBonded == "" {
+		return errors.New("the staking module accounts are missing")
+	}
+
+	bank, err := genesisObject(genesis, "app_state.bank")
+	if err != nil {
+		return err
+	}
+
+	bondedBalance := genesisBalance(bank, bonded)
+	bondedTokens, err := coinAmount(genesisList(bondedBalance, "coins"), bondDenom)
+	if err != nil {
+		return err
+	}
+
+	if bondedBalance["coins"], err = addCoin(genesisList(bondedBalance, "coins"), bondDenom, new(big.Int).Sub(tokens, bondedTokens)); err != nil {
+		return err
+	}
+
+	notBondedBalance := genesisBalance(bank, notBonded)
+	if notBondedBalance["coins"], err = addCoin(genesisList(notBondedBalance, "coins"), bondDenom, bondedTokens); err != nil {
+		return err
+	}
+
+	bank["supply"], err = addCoin(genesisList(bank, "supply"), bondDenom, tokens)
+	return err
+}
+
+// forkSlashing adds the signing info of the validator.
+func forkSlashing(genesis map[string]interface{}, v forkValidator) error {
+	slashing, err := genesisObject(genesis, "app_state.slashing")
+	if err != nil {
+		return err
+	}
+
+	isOtherValidator := func(info map[string]interface{}) bool {
+		return genesisString(info, "address") != v.consensusAddress
+	}
+
+	slashing["signing_infos"] = append(
+		filterGenesisList(genesisList(slashing, "signing_infos"), isOtherValidator),
+		map[string]interface{}{
+			"address": v.consensusAddress,
+			"validator_signing_info": map[string]interface{}{
+				"address":               v.consensusAddress,
+				"start_height":          "0",
+				"index_offset":          "0",
+				"jailed_until":          zeroTime,
+				"tombstoned":            false,
+				"missed_blocks_counter": "0",
+			},
+		},
+	)
+
+	slashing["missed_blocks"] = append(
+		filterGenesisList(genesisList(slashing, "missed_blocks"), isOtherValidator),
+		map[string]interface{}{
+			"address":       v.consensusAddress,
+			"missed_blocks": []interface{}{},
+		},
+	)
+
+	return nil
+}
+
+// forkDistribution adds the reward records of the validator and its self delegation.
+// The records are the same that the distribution module creates for a new validator.
+func forkDistribution(genesis map[string]interface{}, v forkValidator) error {
+	distribution, err := genesisObject(genesis, "app_state.distribution")
+	if err != nil {
+		return err
+	}
+
+	isOtherValidator := func(record map[string]interface{}) bool {
+		return genesisString(record, "validator_address") != v.operatorAddress
+	}
+
+	records := map[string]interface{}{
+		"outstanding_rewards": map[string]interface{}{
+			"validator_address":   v.operatorAddress,
+			"outstanding_rewards": []interface{}{},
+		},
+		"validator_accumulated_commissions": map[string]interface{}{
+			"validator_address": v.operatorAddress,
+			"accumulated":       map[string]interface{}{"commission": []interface{}{}},
+		},
+		"validator_historical_rewards": map[string]interface{}{
+			"validator_address": v.operatorAddress,
+			"period":            "1",
+			"rewards": map[string]interface{}{
+				"cumulative_reward_ratio": []interface{}{},
+				"reference_count":         2,
+			},
+		},
+		"validator_current_rewards": map[string]interface{}{
+			"validator_address": v.operatorAddress,
+			"rewards": map[string]interface{}{
+				"rewards": []interface{}{},
+				"period":  "2",
+			},
+		},
+		"delegator_starting_infos": map[string]interface{}{
+			"delegator_address": v.delegatorAddress,
+			"validator_address": v.operatorAddress,
+			"starting_info": map[string]interface{}{
+				"previous_period": "1",
+				"stake":           v.tokens.String() + decFractional,
+				"height":          "0",
+			},
+		},
+	}
+
+	for key, record := range records {
+		distribution[key] = append(filterGenesisList(genesisList(distribution, key), isOtherValidator), record)
+	}
+
+	return nil
+}
+
+// fundForkAccounts adds the balances of an initialized genesis to a forked genesis.
+// The accounts that don't exist in the forked genesis are added to it.
+func fundForkAccounts(genesis, initialized map[string]interface{}) error {
+	bank, err := genesisObject(genesis, "app_state.bank")
+	if err != nil {
+		return err
+	}
+
+	auth, err := genesisObject(genesis, "app_state.auth")
+	if err != nil {
+		return err
+	}
+
+	initializedBank, err := genesisObject(initialized, "app_state.bank")
+	if err != nil {
+		return err
+	}
+
+	initializedAuth, err := genesisObject(initialized, "app_state.auth")
+	if err != nil {
+		return err
+	}
+
+	accounts := make(map[string]bool)
+	nextAccountNumber := big.NewInt(0)
+	for _, acc := range genesisList(auth, "accounts") {
+		base := baseAccount(acc)
+		accounts[genesisString(base, "address")] = true
+
+		n, err := parseGenesisInt(base["account_number"])
+		if err != nil {
+			return err
+		}
+
+		if n.Cmp(nextAccountNumber) >= 0 {
+			nextAccountNumber.Add(n, big.NewInt(1))
+		}
+	}
+
+	for _, acc := range genesisList(initializedAuth, "accounts") {
+		base := baseAccount(acc)
+		if accounts[genesisString(base, "address")] {
+			continue
+		}
+
+		base["account_number"] = nextAccountNumber.String()
+		nextAccountNumber.Add(nextAccountNumber, big.NewInt(1))
+
+		auth["accounts"] = append(genesisList(auth, "accounts"), acc)
+	}
+
+	for _, b := range genesisList(initializedBank, "balances") {
+		b, _ := b.(map[string]interface{})
+		balance := genesisBalance(bank, genesisString(b, "address"))
+
+		for _, coin := range genesisList(b, "coins") {
+			coin, _ := coin.(map[string]interface{})
+			denom := genesisString(coin, "denom")
+
+			amount, err := parseGenesisInt(coin["amount"])
+			if err != nil {
+				return err
+			}
+
+			if balance["coins"], err = addCoin(genesisList(balance, "coins"), denom, amount); err != nil {
+				return err
+			}
+
+			if bank["supply"], err = addCoin(genesisList(bank, "supply"), denom, amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// genesisModuleAddresses returns the addresses of the module accounts of a genesis by module name.
+func genesisModuleAddresses(genesis map[string]interface{}) (map[string]string, error) {
+	auth, err := genesisObject(genesis, "app_state.auth")
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]string)
+	for _, acc := range genesisList(auth, "accounts") {
+		acc, _ := acc.(map[string]interface{})
+		if name := genesisString(acc, "name"); name != "" {
+			addresses[name] = genesisString(baseAccount(acc), "address")
+		}
+	}
+
+	return addresses, nil
+}
+
+// baseAccount returns the base account of a genesis account, which holds its address and number.
+func baseAccount(acc interface{}) map[string]interface{} {
+	obj, _ := acc.(map[string]interface{})
+	if _, ok := obj["address"]; ok {
+		return obj
+	}
+
+	for _, key := range []string{"base_account", "base_vesting_account"} {
+		if nested, ok := obj[key]; ok {
+			return baseAccount(nested)
+		}
+	}
+
+	return map[string]interface{}{}
+}
+
+// genesisBalance returns the bank balance of an address, the balance is added when it doesn't exist.
+func genesisBalance(bank map[string]interface{}, address string) map[string]interface{} {
+	for _, b := range genesisList(bank, "balances") {
+		if b, ok := b.(map[string]interface{}); ok && genesisString(b, "address") == address {
+			return b
+		}
+	}
+
+	b := map[string]interface{}{
+		"address": address,
+		"coins":   []interface{}{},
+	}
+	bank["balances"] = append(genesisList(bank, "balances"), b)
+
+	return b
+}
+
+// coinAmount returns the amount of a denom in a list of coins.
+func coinAmount(coins []interface{}, denom string) (*big.Int, error) {
+	for _, c := range coins {
+		if c, ok := c.(map[string]interface{}); ok && genesisString(c, "denom") == denom {
+			return parseGenesisInt(c["amount"])
+		}
+	}
+
+	return big.NewInt(0), nil
+}
+
+// addCoin adds an amount of a denom to a list of coins. The coins are kept sorted by denom
+// and the coins without amount are removed.
+func addCoin(coins []interface{}, denom string, amount *big.Int) ([]interface{}, error) {
+	total, err := coinAmount(coins, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	total.Add(total, amount)
+	if total.Sign() < 0 {
+		return nil, fmt.Errorf("negative amount of %s", denom)
+	}
+
+	coins = filterGenesisList(coins, func(c map[string]interface{}) bool {
+		return genesisString(c, "denom") != denom
+	})
+
+	if total.Sign() > 0 {
+		coins = append(coins, map[string]interface{}{"denom": denom, "amount": total.String()})
+	}
+
+	sort.SliceStable(coins, func(i, j int) bool {
+		ci, _ := coins[i].(map[string]interface{})
+		cj, _ := coins[j].(map[string]interface{})
+		return genesisString(ci, "denom") < genesisString(cj, "denom")
+	})
+
+	return coins, nil
+}
+
+// genesisObject returns the object of a decoded genesis at a path with keys separated by dots.
+func genesisObject(genesis map[string]interface{}, path string) (map[string]interface{}, error) {
+	obj := genesis
+	for _, key := range strings.Split(path, ".") {
+		next, ok := obj[key].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("genesis field %q is missing", path)
+		}
+
+		obj = next
+	}
+
+	return obj, nil
+}
+
+func genesisList(obj map[string]interface{}, key string) []interface{} {
+	list, _ := obj[key].([]interface{})
+	return list
+}
+
+func genesisString(obj map[string]interface{}, key string) string {
+	s, _ := obj[key].(string)
+	return s
+}
+
+// filterGenesisList returns a new list with the objects of a list that satisfy keep.
+func filterGenesisList(list []interface{}, keep func(map[string]interface{}) bool) []interface{} {
+	filtered := make([]interface{}, 0, len(list))
+	for _, item := range list {
+		if obj, ok := item.(map[string]interface{}); !ok || keep(obj) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
+
+// parseGenesisInt parses an integer of a genesis, which are encoded as strings or numbers.
+func parseGenesisInt(v interface{}) (*big.Int, error) {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	default:
+		return nil, fmt.Errorf("invalid genesis integer: %v", v)
+	}
+
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid genesis integer: %s", s)
+	}
+
+	return n, nil
+}
+
+// readGenesis reads a genesis file keeping its numbers as they are to not lose precision.
+func readGenesis(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var genesis map[string]interface{}
+	if err := dec.Decode(&genesis); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+
+func writeGenesis(path string, genesis map[string]interface{}) error {
+	data, err := json.MarshalIndent(genesis, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,127 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testForkOperator  = "cosmosvaloper1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc56kct20"
+	testForkDelegator = "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	testForkNetworkOp = "cosmosvaloper1enxvenxvenxvenxvenxvenxvenxvenxv4msryn"
+	testForkAccount   = "cosmos1enxvenxvenxvenxvenxvenxvenxvenxvs0ykgq"
+	testForkBonded    = "cosmos142424242424242424242424242424242a7m5mu"
+	testForkNotBonded = "cosmos1hwamhwamhwamhwamhwamhwamhwamhwam0qvfww"
+
+	testForkPrivValidatorKey = `{
+  "address": "DDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDD",
+  "pub_key": {
+    "type": "tendermint/PubKeyEd25519",
+    "value": "3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d3d0="
+  }
+}`
+)
+
+func TestForkGenesis(t *testing.T) {
+	initialized, err := readGenesis("testdata/fork/initialized_genesis.json")
+	require.NoError(t, err)
+
+	genesis, err := readGenesis("testdata/fork/exported_genesis.json")
+	require.NoError(t, err)
+
+	v, err := newForkValidator(initialized, []byte(testForkPrivValidatorKey))
+	require.NoError(t, err)
+	require.Equal(t, testForkOperator, v.operatorAddress)
+	require.Equal(t, "cosmosvalcons1mhwamhwamhwamhwamhwamhwamhwamhwank5zaq", v.consensusAddress)
+	require.Equal(t, "100000000", v.tokens.String())
+
+	require.NoError(t, forkGenesis(genesis, "mars-1", v))
+	require.Equal(t, "mars-1", genesis["chain_id"])
+	require.Empty(t, genesis["app_state"].(map[string]interface{})["genutil"].(map[string]interface{})["gen_txs"])
+
+	// the local validator is the only one in the Tendermint validator set
+	validators := genesis["validators"].([]interface{})
+	require.Len(t, validators, 1)
+	require.Equal(t, "DDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDD", validators[0].(map[string]interface{})["address"])
+	require.Equal(t, "100", validators[0].(map[string]interface{})["power"])
+
+	staking, err := genesisObject(genesis, "app_state.staking")
+	require.NoError(t, err)
+	require.Equal(t, "100", staking["last_total_power"])
+
+	stakingValidators := genesisList(staking, "validators")
+	require.Len(t, stakingValidators, 2)
+
+	networkValidator := stakingValidators[0].(map[string]interface{})
+	require.Equal(t, testForkNetworkOp, networkValidator["operator_address"])
+	require.Equal(t, true, networkValidator["jailed"])
+	require.Equal(t, bondStatusUnbonded, networkValidator["status"])
+
+	localValidator := stakingValidators[1].(map[string]interface{})
+	require.Equal(t, testForkOperator, localValidator["operator_address"])
+	require.Equal(t, bondStatusBonded, localValidator["status"])
+	require.Equal(t, "100000000", localValidator["tokens"])
+
+	// the pools hold the tokens of the validators
+	bank, err := genesisObject(genesis, "app_state.bank")
+	require.NoError(t, err)
+	requireCoins(t, map[string]string{"uatom": "100000000"}, genesisBalance(bank, testForkBonded))
+	requireCoins(t, map[string]string{"uatom": "5000000000"}, genesisBalance(bank, testForkNotBonded))
+	requireCoins(t, map[string]string{"uatom": "5100001000"}, map[string]interface{}{"coins": bank["supply"]})
+
+	slashing, err := genesisObject(genesis, "app_state.slashing")
+	require.NoError(t, err)
+	require.Len(t, genesisList(slashing, "signing_infos"), 2)
+	require.Len(t, genesisList(slashing, "missed_blocks"), 1)
+
+	distribution, err := genesisObject(genesis, "app_state.distribution")
+	require.NoError(t, err)
+	require.Len(t, genesisList(distribution, "outstanding_rewards"), 2)
+	require.Len(t, genesisList(distribution, "delegator_starting_infos"), 1)
+
+	// the accounts are funded
+	require.NoError(t, fundForkAccounts(genesis, initialized))
+
+	auth, err := genesisObject(genesis, "app_state.auth")
+	require.NoError(t, err)
+
+	accounts := genesisList(auth, "accounts")
+	require.Len(t, accounts, 4)
+	require.Equal(t, testForkDelegator, accounts[3].(map[string]interface{})["address"])
+	require.Equal(t, "6", accounts[3].(map[string]interface{})["account_number"])
+
+	requireCoins(t, map[string]string{"stake": "200000000", "token": "20000"}, genesisBalance(bank, testForkDelegator))
+	requireCoins(t, map[string]string{"uatom": "1500"}, genesisBalance(bank, testForkAccount))
+	requireCoins(t, map[string]string{"stake": "200000000", "token": "20000", "uatom": "5100001500"}, map[string]interface{}{"coins": bank["supply"]})
+}
+
+func TestForkGenesisWithoutVotingPower(t *testing.T) {
+	genesis, err := readGenesis("testdata/fork/exported_genesis.json")
+	require.NoError(t, err)
+
+	initialized, err := readGenesis("testdata/fork/initialized_genesis.json")
+	require.NoError(t, err)
+
+	v, err := newForkValidator(initialized, []byte(testForkPrivValidatorKey))
+	require.NoError(t, err)
+
+	v.tokens.SetInt64(999999)
+
+	require.Error(t, forkGenesis(genesis, "mars-1", v))
+}
+
+func requireCoins(t *testing.T, want map[string]string, balance map[string]interface{}) {
+	t.Helper()
+
+	got := make(map[string]string)
+	var denoms []string
+	for _, c := range genesisList(balance, "coins") {
+		c := c.(map[string]interface{})
+		got[genesisString(c, "denom")] = genesisString(c, "amount")
+		denoms = append(denoms, genesisString(c, "denom"))
+	}
+
+	require.Equal(t, want, got)
+	require.IsIncreasing(t, denoms)
+}
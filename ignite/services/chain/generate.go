@@ -149,6 +149,7 @@ func (c *Chain) Generate(
 				cosmosgen.TypescriptModulePath(tsClientPath),
 				tsClientPath,
 			),
+			cosmosgen.WithDenomMetadata(chainconfig.ConfigDenomMetadata(conf)),
 		)
 	}
 
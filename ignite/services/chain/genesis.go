@@ -0,0 +1,157 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/otiai10/copy"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+)
+
+// GenesisValidationError is returned when the validate-genesis command of the chain fails.
+type GenesisValidationError struct {
+	// Message is the error returned by the command.
+	Message string
+
+	// Paths are the JSON paths of the genesis values that caused the error.
+	Paths []string
+}
+
+// Error implements the error interface.
+func (e GenesisValidationError) Error() string {
+	if len(e.Paths) == 0 {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s\ncheck the genesis values at: %s", e.Message, strings.Join(e.Paths, ", "))
+}
+
+// AddGenesisAccount adds an account with coins to a genesis file using the chain's binary.
+func (c *Chain) AddGenesisAccount(ctx context.Context, genesisPath, address, coins string) error {
+	return c.genesisCommands(ctx, genesisPath, true, func(commands chaincmdrunner.Runner) error {
+		return commands.AddGenesisAccount(ctx, address, coins)
+	})
+}
+
+// AddGenesisVestingAccount adds a vesting account with coins to a genesis file using the chain's binary.
+func (c *Chain) AddGenesisVestingAccount(
+	ctx context.Context,
+	genesisPath,
+	address,
+	coins,
+	vestingCoins string,
+	vestingEndTime int64,
+) error {
+	return c.genesisCommands(ctx, genesisPath, true, func(commands chaincmdrunner.Runner) error {
+		return commands.AddVestingAccount(ctx, address, coins, vestingCoins, vestingEndTime)
+	})
+}
+
+// ValidateGenesis validates a genesis file using the chain's binary.
+// A GenesisValidationError is returned when the genesis is not valid.
+func (c *Chain) ValidateGenesis(ctx context.Context, genesisPath string) error {
+	err := c.genesisCommands(ctx, genesisPath, false, func(commands chaincmdrunner.Runner) error {
+		return commands.ValidateGenesis(ctx)
+	})
+	if err == nil {
+		return nil
+	}
+
+	g, gErr := genesis.FromPath(genesisPath)
+	if gErr != nil {
+		return err
+	}
+	defer g.Close()
+
+	message := strings.TrimSpace(err.Error())
+	paths, gErr := g.ValidationErrorPaths(message)
+	if gErr != nil {
+		return err
+	}
+
+	return GenesisValidationError{
+		Message: message,
+		Paths:   paths,
+	}
+}
+
+// genesisCommands runs chain commands on a genesis file.
+// The commands of the chain work on the genesis of a home, so when the file is not the
+// chain's genesis they run on a temporary home and, if update is true, the updated
+// genesis is copied back to the file.
+func (c *Chain) genesisCommands(
+	ctx context.Context,
+	genesisPath string,
+	update bool,
+	run func(chaincmdrunner.Runner) error,
+) error {
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	nodes, err := c.nodes(conf)
+	if err != nil {
+		return err
+	}
+
+	n := nodes[0]
+
+	chainGenesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	if isSamePath(genesisPath, chainGenesisPath) {
+		commands, err := c.nodeCommands(ctx, n)
+		if err != nil {
+			return err
+		}
+
+		return run(commands)
+	}
+
+	if n.home, err = os.MkdirTemp("", ""); err != nil {
+		return err
+	}
+	defer os.RemoveAll(n.home)
+
+	homeGenesisPath := filepath.Join(n.home, "config/genesis.json")
+	if err := copy.Copy(genesisPath, homeGenesisPath); err != nil {
+		return err
+	}
+
+	commands, err := c.nodeCommands(ctx, n)
+	if err != nil {
+		return err
+	}
+
+	if err := run(commands); err != nil {
+		return err
+	}
+
+	if !update {
+		return nil
+	}
+
+	return copy.Copy(homeGenesisPath, genesisPath)
+}
+
+func isSamePath(a, b string) bool {
+	a, err := filepath.Abs(a)
+	if err != nil {
+		return false
+	}
+
+	b, err = filepath.Abs(b)
+	if err != nil {
+		return false
+	}
+
+	return a == b
+}
@@ -0,0 +1,167 @@
+package chain
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/jsonmigrate"
+)
+
+const (
+	// genesisMigrationsDir is the directory of the app that contains the migrations of the exported genesis
+	genesisMigrationsDir = "genesis_migrations"
+
+	// genesisMigrationsCacheNamespace is the name of the cache namespace for the applied genesis migrations
+	genesisMigrationsCacheNamespace = "serve.genesis_migrations"
+)
+
+// genesisMigrationExts are the extensions of the genesis migration files.
+var genesisMigrationExts = map[string]bool{
+	".yml":  true,
+	".yaml": true,
+	".json": true,
+}
+
+// GenesisMigrationError is returned when a genesis migration can't be applied to the exported genesis.
+type GenesisMigrationError struct {
+	Migration string
+	Err       error
+}
+
+func (e *GenesisMigrationError) Error() string {
+	return fmt.Sprintf("cannot apply the %s genesis migration: %s", e.Migration, e.Err)
+}
+
+func (e *GenesisMigrationError) Unwrap() error {
+	return e.Err
+}
+
+// genesisMigrations returns the names of the genesis migration files in the order they are applied.
+func (c *Chain) genesisMigrations() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(c.app.Path, genesisMigrationsDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// entries are sorted by file name
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || !genesisMigrationExts[filepath.Ext(entry.Name())] {
+			continue
+		}
+
+		names = append(names, entry.Name())
+	}
+
+	return names, nil
+}
+
+// genesisMigrationsCacheKey returns the key of the applied genesis migrations in the cache.
+func (c *Chain) genesisMigrationsCacheKey() (string, error) {
+	chainID, err := c.ID()
+	if err != nil {
+		return "", err
+	}
+
+	return cache.Key(c.app.Path, chainID), nil
+}
+
+// markGenesisMigrationsApplied marks all the genesis migrations of the app as applied.
+// The migrations are not needed once the state is initialized with the current app.
+func (c *Chain) markGenesisMigrationsApplied(cacheStorage cache.Storage) error {
+	names, err := c.genesisMigrations()
+	if err != nil {
+		return err
+	}
+
+	key, err := c.genesisMigrationsCacheKey()
+	if err != nil {
+		return err
+	}
+
+	return cache.New[[]string](cacheStorage, genesisMigrationsCacheNamespace).Put(key, names)
+}
+
+// migrateExportedGenesis applies the genesis migrations that were not applied yet to the exported genesis.
+// The exported genesis is only updated when all the pending migrations are successfully applied.
+func (c *Chain) migrateExportedGenesis(cacheStorage cache.Storage) error {
+	names, err := c.genesisMigrations()
+	if err != nil || len(names) == 0 {
+		return err
+	}
+
+	key, err := c.genesisMigrationsCacheKey()
+	if err != nil {
+		return err
+	}
+
+	migrationCache := cache.New[[]string](cacheStorage, genesisMigrationsCacheNamespace)
+
+	applied, err := migrationCache.Get(key)
+	if err != nil && err != cache.ErrorNotFound {
+		return err
+	}
+
+	isApplied := make(map[string]bool)
+	for _, name := range applied {
+		isApplied[name] = true
+	}
+
+	var pending []string
+	for _, name := range names {
+		if !isApplied[name] {
+			pending = append(pending, name)
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	genesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return err
+	}
+
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range pending {
+		fmt.Fprintf(c.stdLog().out, "🧬 Applying the %s genesis migration...\n", name)
+
+		if genesis, err = c.applyGenesisMigration(genesis, name); err != nil {
+			return &GenesisMigrationError{name, err}
+		}
+	}
+
+	if err := os.WriteFile(genesisPath, genesis, 0o644); err != nil {
+		return err
+	}
+
+	return migrationCache.Put(key, append(applied, pending...))
+}
+
+// applyGenesisMigration applies the operations of a genesis migration file to a genesis.
+func (c *Chain) applyGenesisMigration(genesis []byte, name string) ([]byte, error) {
+	file, err := os.Open(filepath.Join(c.app.Path, genesisMigrationsDir, name))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	ops, err := jsonmigrate.Parse(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid migration file")
+	}
+
+	return jsonmigrate.ApplyJSON(genesis, ops...)
+}
@@ -0,0 +1,113 @@
+package chain
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+)
+
+func newGenesisMigrationTestChain(t *testing.T) (*Chain, cache.Storage, string) {
+	// the exported genesis is saved in the home directory
+	t.Setenv("HOME", t.TempDir())
+
+	c := newHookTestChain(t)
+
+	cacheStorage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+
+	genesisPath, err := c.exportedGenesisPath()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(genesisPath), 0o755))
+	require.NoError(t, os.WriteFile(genesisPath, []byte(`{"app_state":{"blog":{"posts":[]}}}`), 0o644))
+
+	require.NoError(t, os.Mkdir(filepath.Join(c.app.Path, genesisMigrationsDir), 0o755))
+
+	return c, cacheStorage, genesisPath
+}
+
+func writeGenesisMigration(t *testing.T, c *Chain, name, content string) {
+	err := os.WriteFile(filepath.Join(c.app.Path, genesisMigrationsDir, name), []byte(content), 0o644)
+	require.NoError(t, err)
+}
+
+func TestMigrateExportedGenesis(t *testing.T) {
+	c, cacheStorage, genesisPath := newGenesisMigrationTestChain(t)
+
+	writeGenesisMigration(t, c, "002_rename.yml", `
+- op: rename
+  path: $.app_state.blog
+  to: news
+`)
+	writeGenesisMigration(t, c, "001_params.yml", `
+- op: set
+  path: $.app_state.blog.params.max
+  value: 10
+`)
+	writeGenesisMigration(t, c, "README.md", "not a migration")
+
+	require.NoError(t, c.migrateExportedGenesis(cacheStorage))
+
+	genesis, err := os.ReadFile(genesisPath)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"app_state":{"news":{"params":{"max":10},"posts":[]}}}`, string(genesis))
+
+	// the applied migrations are not applied again
+	writeGenesisMigration(t, c, "003_delete.yml", `
+- op: delete
+  path: $.app_state.news.params
+`)
+	require.NoError(t, os.WriteFile(genesisPath, []byte(`{"app_state":{"news":{"params":{},"posts":[]}}}`), 0o644))
+
+	require.NoError(t, c.migrateExportedGenesis(cacheStorage))
+
+	genesis, err = os.ReadFile(genesisPath)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"app_state":{"news":{"posts":[]}}}`, string(genesis))
+}
+
+func TestMigrateExportedGenesisFailure(t *testing.T) {
+	c, cacheStorage, genesisPath := newGenesisMigrationTestChain(t)
+
+	writeGenesisMigration(t, c, "001_params.yml", `
+- op: set
+  path: $.app_state.blog.params
+  value: {}
+`)
+	writeGenesisMigration(t, c, "002_invalid.yml", `
+- op: set
+  path: $.app_state.blog.posts.title
+  value: ""
+`)
+
+	err := c.migrateExportedGenesis(cacheStorage)
+
+	var migrationErr *GenesisMigrationError
+	require.True(t, errors.As(err, &migrationErr))
+	require.Equal(t, "002_invalid.yml", migrationErr.Migration)
+
+	// the exported genesis is not modified when a migration fails
+	genesis, err := os.ReadFile(genesisPath)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"app_state":{"blog":{"posts":[]}}}`, string(genesis))
+}
+
+func TestMarkGenesisMigrationsApplied(t *testing.T) {
+	c, cacheStorage, genesisPath := newGenesisMigrationTestChain(t)
+
+	writeGenesisMigration(t, c, "001_params.yml", `
+- op: delete
+  path: $.app_state.blog
+`)
+
+	require.NoError(t, c.markGenesisMigrationsApplied(cacheStorage))
+	require.NoError(t, c.migrateExportedGenesis(cacheStorage))
+
+	genesis, err := os.ReadFile(genesisPath)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"app_state":{"blog":{"posts":[]}}}`, string(genesis))
+}
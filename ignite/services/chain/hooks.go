@@ -0,0 +1,171 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/httpstatuschecker"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// Names of the lifecycle hooks as they are defined in the config.
+const (
+	hookPreBuild  = "pre_build"
+	hookPostBuild = "post_build"
+	hookPostInit  = "post_init"
+	hookPostStart = "post_start"
+	hookPreExport = "pre_export"
+)
+
+// Environment variables available to the hook commands.
+const (
+	hookEnvChainID = "IGNITE_CHAIN_ID"
+	hookEnvHome    = "IGNITE_CHAIN_HOME"
+	hookEnvBinary  = "IGNITE_CHAIN_BINARY"
+	hookEnvRPC     = "IGNITE_CHAIN_RPC"
+	hookEnvAPI     = "IGNITE_CHAIN_API"
+)
+
+// HookError is returned when a command of a lifecycle hook fails.
+type HookError struct {
+	Hook    string
+	Command string
+	Err     error
+}
+
+func (e *HookError) Error() string {
+	return fmt.Sprintf("%s hook command %q failed: %s", e.Hook, e.Command, e.Err)
+}
+
+func (e *HookError) Unwrap() error {
+	return e.Err
+}
+
+// runHook runs the commands of a lifecycle hook in order within the app's directory.
+// The commands are executed by the shell and they have access to the chain's env.
+func (c *Chain) runHook(ctx context.Context, hook string, commands []string) error {
+	if len(commands) == 0 {
+		return nil
+	}
+
+	env, err := c.hookEnv()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.stdLog().out, "🪝 Running %s hook...\n", hook)
+
+	for _, command := range commands {
+		errb := &bytes.Buffer{}
+
+		err := cmdrunner.
+			New(
+				cmdrunner.DefaultStdout(c.stdout),
+				cmdrunner.DefaultStderr(io.MultiWriter(c.stderr, errb)),
+				cmdrunner.DefaultWorkdir(c.app.Path),
+			).
+			Run(ctx, step.New(
+				step.Exec("sh", "-c", command),
+				step.Env(env...),
+			))
+
+		if errors.Is(err, context.Canceled) {
+			return err
+		}
+
+		if err != nil {
+			return &HookError{
+				Hook:    hook,
+				Command: command,
+				Err:     errors.Wrap(err, errb.String()),
+			}
+		}
+	}
+
+	return nil
+}
+
+// hookEnv returns the env variables of the chain that are exposed to the hook commands.
+// The home and addresses are the ones of the node of the first validator.
+func (c *Chain) hookEnv() ([]string, error) {
+	chainID, err := c.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	binary, err := c.Binary()
+	if err != nil {
+		return nil, err
+	}
+
+	env := []string{
+		cmdrunner.Env(hookEnvChainID, chainID),
+		cmdrunner.Env(hookEnvHome, home),
+		cmdrunner.Env(hookEnvBinary, binary),
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conf.Validators) > 0 {
+		servers, err := conf.Validators[0].GetServers()
+		if err != nil {
+			return nil, err
+		}
+
+		rpcAddr, _ := xurl.HTTP(servers.RPC.Address)
+		apiAddr, _ := xurl.HTTP(servers.API.Address)
+
+		env = append(env,
+			cmdrunner.Env(hookEnvRPC, rpcAddr),
+			cmdrunner.Env(hookEnvAPI, apiAddr),
+		)
+	}
+
+	return env, nil
+}
+
+// waitNodesHealthy waits until the RPC of all the nodes reports that they are healthy.
+func (c *Chain) waitNodesHealthy(ctx context.Context, nodes []node) error {
+	for _, n := range nodes {
+		servers, err := n.validator.GetServers()
+		if err != nil {
+			return err
+		}
+
+		rpcAddr, err := xurl.HTTP(servers.RPC.Address)
+		if err != nil {
+			return fmt.Errorf("invalid rpc address format %s: %w", servers.RPC.Address, err)
+		}
+
+		checkHealth := func() error {
+			ok, err := httpstatuschecker.Check(ctx, fmt.Sprintf("%s/health", rpcAddr))
+			if err == nil && !ok {
+				err = fmt.Errorf("node %s is not healthy", n.name)
+			}
+			return err
+		}
+
+		err = backoff.Retry(checkHealth, backoff.WithContext(backoff.NewConstantBackOff(time.Second), ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newHookTestChain(t *testing.T) *Chain {
+	return &Chain{
+		app: App{
+			Name: "mars",
+			Path: t.TempDir(),
+		},
+		options: chainOptions{
+			chainID:  "mars-1",
+			homePath: "/tmp/.mars",
+		},
+		addressOverrides: &addressOverrides{},
+		stdout:           io.Discard,
+		stderr:           io.Discard,
+	}
+}
+
+func TestRunHook(t *testing.T) {
+	c := newHookTestChain(t)
+
+	err := c.runHook(context.Background(), hookPostInit, []string{
+		`printf %s "$IGNITE_CHAIN_ID" > chain_id`,
+		`printf %s "$IGNITE_CHAIN_HOME:$IGNITE_CHAIN_BINARY" > chain_home`,
+	})
+	require.NoError(t, err)
+
+	chainID, err := os.ReadFile(filepath.Join(c.app.Path, "chain_id"))
+	require.NoError(t, err)
+	require.Equal(t, "mars-1", string(chainID))
+
+	home, err := os.ReadFile(filepath.Join(c.app.Path, "chain_home"))
+	require.NoError(t, err)
+	require.Equal(t, "/tmp/.mars:marsd", string(home))
+}
+
+func TestRunHookFailure(t *testing.T) {
+	c := newHookTestChain(t)
+
+	err := c.runHook(context.Background(), hookPreBuild, []string{
+		"exit 1",
+		"touch not_created",
+	})
+
+	var hookErr *HookError
+	require.True(t, errors.As(err, &hookErr))
+	require.Equal(t, hookPreBuild, hookErr.Hook)
+	require.Equal(t, "exit 1", hookErr.Command)
+
+	// commands after the failing one are not executed
+	require.NoFileExists(t, filepath.Join(c.app.Path, "not_created"))
+}
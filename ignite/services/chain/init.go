@@ -12,6 +12,8 @@ import (
 	"github.com/ignite/cli/ignite/chainconfig"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/confile"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+	"github.com/ignite/cli/ignite/pkg/events"
 )
 
 const (
@@ -19,7 +21,10 @@ const (
 )
 
 // Init initializes the chain and applies all optional configurations.
-func (c *Chain) Init(ctx context.Context, initAccounts bool) error {
+func (c *Chain) Init(ctx context.Context, initAccounts bool) (err error) {
+	start := c.phaseStarted(events.PhaseInit)
+	defer func() { c.phaseDone(events.PhaseInit, start, err) }()
+
 	conf, err := c.Config()
 	if err != nil {
 		return &CannotBuildAppError{err}
@@ -30,8 +35,15 @@ func (c *Chain) Init(ctx context.Context, initAccounts bool) error {
 	}
 
 	if initAccounts {
-		return c.InitAccounts(ctx, conf)
+		if err := c.InitAccounts(ctx, conf); err != nil {
+			return err
+		}
+	}
+
+	if err := c.runHook(ctx, hookPostInit, conf.Hooks.PostInit); err != nil {
+		return &CannotBuildAppError{err}
 	}
+
 	return nil
 }
 
@@ -47,27 +59,40 @@ func (c *Chain) InitChain(ctx context.Context) error {
 		return err
 	}
 
-	// cleanup persistent data from previous `serve`.
-	home, err := c.Home()
+	nodes, err := c.nodes(conf)
 	if err != nil {
 		return err
 	}
-	if err := os.RemoveAll(home); err != nil {
-		return err
-	}
 
-	commands, err := c.Commands(ctx)
+	preset, err := chainconfig.ConfigDevPreset(conf)
 	if err != nil {
 		return err
 	}
 
-	// init node.
-	if err := commands.Init(ctx, moniker); err != nil {
-		return err
+	for _, n := range nodes {
+		// cleanup persistent data from previous `serve`.
+		if err := os.RemoveAll(n.home); err != nil {
+			return err
+		}
+
+		commands, err := c.nodeCommands(ctx, n)
+		if err != nil {
+			return err
+		}
+
+		// init node.
+		if err := commands.Init(ctx, n.moniker); err != nil {
+			return err
+		}
+
+		// ovewrite app config files with the values defined in Ignite's config file
+		if err := c.plugin.Configure(n.home, n.validator, preset); err != nil {
+			return err
+		}
 	}
 
-	// ovewrite app config files with the values defined in Ignite's config file
-	if err := c.plugin.Configure(home, conf); err != nil {
+	// set the genesis values of the dev preset, the values of the config have priority
+	if err := c.applyDevPreset(conf, preset); err != nil {
 		return err
 	}
 
@@ -81,6 +106,11 @@ func (c *Chain) InitChain(ctx context.Context) error {
 		return err
 	}
 
+	// add the denom metadata of the tokens defined in the config
+	if err := c.updateGenesisTokens(conf); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -128,9 +158,39 @@ func (c *Chain) InitAccounts(ctx context.Context, conf *chainconfig.Config) erro
 		}
 	}
 
-	_, err = c.IssueGentx(ctx, createValidatorFromConfig(conf))
+	nodes, err := c.nodes(conf)
+	if err != nil {
+		return err
+	}
+
+	// a single validator doesn't require any extra node setup
+	if len(nodes) == 1 {
+		_, err = c.IssueGentx(ctx, createValidatorFromConfig(nodes[0]))
+		return err
+	}
+
+	// create the gentxs for all the validators using the keyring of the first node
+	for _, n := range nodes {
+		v := createValidatorFromConfig(n)
+
+		// the gentxs of the rest of the validators must be issued for their own node
+		if !n.primary {
+			if v.NodeID, v.PubKey, err = c.nodeValidatorKeys(ctx, n); err != nil {
+				return err
+			}
+		}
+
+		if _, err := c.plugin.Gentx(ctx, commands, v); err != nil {
+			return err
+		}
+	}
+
+	// import all the gentxs into a single genesis
+	if err := commands.CollectGentxs(ctx); err != nil {
+		return err
+	}
 
-	return err
+	return c.connectNodes(ctx, nodes)
 }
 
 // IssueGentx generates a gentx from the validator information in chain config and import it in the chain genesis
@@ -193,6 +253,47 @@ func (c Chain) updateGenesisFile(data map[string]interface{}) error {
 	return nil
 }
 
+// updateGenesisTokens adds the denom metadata of the config tokens to the genesis.
+// The metadata of the genesis values defined in the config has priority.
+func (c Chain) updateGenesisTokens(conf *chainconfig.Config) error {
+	if len(conf.Tokens) == 0 {
+		return nil
+	}
+
+	path, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	g, err := genesis.FromPath(path)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	metadata, err := g.DenomMetadata()
+	if err != nil {
+		return err
+	}
+
+	bases := make(map[string]bool)
+	for _, m := range metadata {
+		bases[m.Base] = true
+	}
+
+	for _, m := range chainconfig.ConfigDenomMetadata(conf) {
+		if bases[m.Base] {
+			continue
+		}
+
+		if err := g.AddDenomMetadata(m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type Validator struct {
 	Name                    string
 	Moniker                 string
@@ -206,6 +307,14 @@ type Validator struct {
 	Identity                string
 	Website                 string
 	SecurityContact         string
+
+	// NodeID is the ID of the node the validator runs.
+	// When empty the ID of the chain's node is used.
+	NodeID string
+
+	// PubKey is the validator consensus public key.
+	// When empty the key of the chain's node is used.
+	PubKey string
 }
 
 // Account represents an account in the chain.
@@ -217,12 +326,16 @@ type Account struct {
 	Coins    string
 }
 
-func createValidatorFromConfig(conf *chainconfig.Config) (validator Validator) {
-	// Currently, we support the config file with one valid validator.
-	validatorFromConfig := conf.Validators[0]
+func createValidatorFromConfig(n node) (validator Validator) {
+	validatorFromConfig := n.validator
 	validator.Name = validatorFromConfig.Name
 	validator.StakingAmount = validatorFromConfig.Bonded
 
+	// the moniker of the rest of the validators is required to be able to distinguish them
+	if !n.primary {
+		validator.Moniker = n.moniker
+	}
+
 	if validatorFromConfig.Gentx != nil {
 		if validatorFromConfig.Gentx.Amount != "" {
 			validator.StakingAmount = validatorFromConfig.Gentx.Amount
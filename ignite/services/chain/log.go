@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"strings"
@@ -69,3 +70,12 @@ func (c *Chain) genPrefix(logType logType) string {
 		New(prefix.Name, prefixgen.Common(prefixgen.Color(prefix.Color))...).
 		Gen(c.app.Name)
 }
+
+// genNodePrefix returns the log prefix for the daemon of a validator node.
+func (c *Chain) genNodePrefix(validatorName string) string {
+	prefix := prefixes[logAppd]
+
+	return prefixgen.
+		New(prefix.Name, prefixgen.Common(prefixgen.Color(prefix.Color))...).
+		Gen(fmt.Sprintf("%s %s", c.app.Name, validatorName))
+}
@@ -0,0 +1,266 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/otiai10/copy"
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/xnet"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// node is a local validator node of the chain.
+// Each validator defined in the config runs its own node.
+type node struct {
+	// name of the validator that runs the node.
+	name string
+
+	// moniker of the node.
+	moniker string
+
+	// home is the data directory of the node.
+	home string
+
+	// validator is the validator config for the node.
+	validator chainconfig.Validator
+
+	// primary indicates that the node uses the chain's home.
+	// The primary node holds the chain's keyring and it is
+	// the one where the genesis file is built.
+	primary bool
+}
+
+// nodes returns the validator nodes defined in the config.
+// The node of the first validator always uses the chain's home.
+func (c *Chain) nodes(conf *chainconfig.Config) ([]node, error) {
+	home, err := c.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	// homes are the validators using each home, the nodes can't share a home
+	homes := make(map[string]string, len(conf.Validators))
+
+	nodes := make([]node, len(conf.Validators))
+	for i, v := range conf.Validators {
+		n := node{
+			name:      v.Name,
+			moniker:   moniker,
+			home:      home,
+			validator: v,
+			primary:   i == 0,
+		}
+
+		// The rest of the validators use their own home
+		if i > 0 {
+			n.moniker = v.Name
+
+			if v.Home != "" {
+				n.home = os.ExpandEnv(v.Home)
+			} else {
+				n.home = fmt.Sprintf("%s-%s", home, v.Name)
+			}
+		}
+
+		cleanHome := filepath.Clean(n.home)
+		if name, ok := homes[cleanHome]; ok {
+			return nil, fmt.Errorf("validator %q can't use the home of validator %q: %s", v.Name, name, n.home)
+		}
+		homes[cleanHome] = v.Name
+
+		nodes[i] = n
+	}
+
+	return nodes, nil
+}
+
+// nodeCommands returns the runner to execute commands on the chain's binary for a node.
+func (c *Chain) nodeCommands(ctx context.Context, n node) (chaincmdrunner.Runner, error) {
+	id, err := c.ID()
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	binary, err := c.Binary()
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	servers, err := n.validator.GetServers()
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	nodeAddr, err := xurl.TCP(servers.RPC.Address)
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	chainCommandOptions := []chaincmd.Option{
+		chaincmd.WithChainID(id),
+		chaincmd.WithHome(n.home),
+		chaincmd.WithVersion(c.Version),
+		chaincmd.WithNodeAddress(nodeAddr),
+		chaincmd.WithKeyringBackend(backend),
+	}
+
+	cc := chaincmd.New(binary, chainCommandOptions...)
+
+	ccrOptions := make([]chaincmdrunner.Option, 0)
+	if c.logLevel == LogVerbose {
+		prefix := c.genPrefix(logAppd)
+		if !n.primary {
+			prefix = c.genNodePrefix(n.name)
+		}
+
+		ccrOptions = append(ccrOptions,
+			chaincmdrunner.Stdout(os.Stdout),
+			chaincmdrunner.Stderr(os.Stderr),
+			chaincmdrunner.DaemonLogPrefix(prefix),
+		)
+	}
+
+	return chaincmdrunner.New(ctx, cc, ccrOptions...)
+}
+
+// nodeValidatorKeys returns the node ID and the validator consensus public key of a node.
+func (c *Chain) nodeValidatorKeys(ctx context.Context, n node) (nodeID, pubKey string, err error) {
+	commands, err := c.nodeCommands(ctx, n)
+	if err != nil {
+		return "", "", err
+	}
+
+	if nodeID, err = commands.ShowNodeID(ctx); err != nil {
+		return "", "", err
+	}
+
+	if pubKey, err = commands.ShowValidator(ctx); err != nil {
+		return "", "", err
+	}
+
+	return nodeID, pubKey, nil
+}
+
+// connectNodes shares the genesis of the first node with the rest of
+// the nodes and configures all of them to be persistent peers.
+func (c *Chain) connectNodes(ctx context.Context, nodes []node) error {
+	// There is nothing to connect when the chain runs a single node
+	if len(nodes) < 2 {
+		return nil
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	for _, n := range nodes[1:] {
+		if err := copy.Copy(genesisPath, filepath.Join(n.home, "config/genesis.json")); err != nil {
+			return err
+		}
+	}
+
+	return c.updateNodePeers(ctx, nodes)
+}
+
+// updateNodePeers configures all the nodes to be persistent peers of each other.
+func (c *Chain) updateNodePeers(ctx context.Context, nodes []node) (err error) {
+	// There are no peers when the chain runs a single node
+	if len(nodes) < 2 {
+		return nil
+	}
+
+	peers := make([]string, len(nodes))
+	for i, n := range nodes {
+		if peers[i], err = c.nodePeerAddress(ctx, n); err != nil {
+			return err
+		}
+	}
+
+	for i, n := range nodes {
+		// Each node uses all the other nodes as persistent peers
+		var nodePeers []string
+		for j, p := range peers {
+			if i != j {
+				nodePeers = append(nodePeers, p)
+			}
+		}
+
+		if err := setNodePeers(n.home, nodePeers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nodePeerAddress returns the local P2P address of a node.
+func (c *Chain) nodePeerAddress(ctx context.Context, n node) (string, error) {
+	commands, err := c.nodeCommands(ctx, n)
+	if err != nil {
+		return "", err
+	}
+
+	nodeID, err := commands.ShowNodeID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	servers, err := n.validator.GetServers()
+	if err != nil {
+		return "", err
+	}
+
+	// The P2P address usually has a TCP scheme, e.g. "tcp://0.0.0.0:26656"
+	_, address := xurl.SplitScheme(servers.P2P.Address)
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid p2p address format %s: %w", servers.P2P.Address, err)
+	}
+
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return "", fmt.Errorf("invalid p2p address port %s: %w", servers.P2P.Address, err)
+	}
+
+	return fmt.Sprintf("%s@%s", nodeID, xnet.LocalhostIPv4Address(p)), nil
+}
+
+// setNodePeers sets the persistent peers in the config.toml of a node.
+func setNodePeers(home string, peers []string) error {
+	path := filepath.Join(home, "config/config.toml")
+	config, err := toml.LoadFile(path)
+	if err != nil {
+		return err
+	}
+
+	config.Set("p2p.persistent_peers", strings.Join(peers, ","))
+
+	// All the nodes run in the same host
+	config.Set("p2p.allow_duplicate_ip", true)
+	config.Set("p2p.addr_book_strict", false)
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = config.WriteTo(file)
+	return err
+}
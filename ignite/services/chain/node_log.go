@@ -0,0 +1,132 @@
+package chain
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/nodelog"
+	"github.com/ignite/cli/ignite/pkg/rotatefile"
+)
+
+const (
+	// nodeLogsDir is the directory in the chain save path where the node logs are saved.
+	nodeLogsDir = "logs"
+
+	// nodeLogFormat is the format of the node logs, JSON logs can be filtered and rendered.
+	nodeLogFormat = "json"
+)
+
+// nodeLogOptions configures the logs of the nodes when the chain is served.
+type nodeLogOptions struct {
+	// filter selects the log entries of the nodes that are printed.
+	// The node logs are printed when it is set even when the output is not verbose.
+	filter *nodelog.Filter
+
+	// saveFile enables saving the node logs in a rotating file.
+	saveFile bool
+}
+
+// nodeLogs holds the writers of the logs of a node.
+type nodeLogs struct {
+	stdout, stderr *nodelog.Writer
+	file           *rotatefile.File
+}
+
+// Close writes the pending log lines and closes the log file.
+func (l nodeLogs) Close() error {
+	for _, w := range []*nodelog.Writer{l.stdout, l.stderr} {
+		if w != nil {
+			w.Flush()
+		}
+	}
+
+	if l.file != nil {
+		return l.file.Close()
+	}
+
+	return nil
+}
+
+// nodeLogCommands returns the runner with the outputs of a node that is started, which
+// render the JSON logs of the node, filter them and save them in a rotating file.
+func (c *Chain) nodeLogCommands(commands chaincmdrunner.Runner, n node) (chaincmdrunner.Runner, nodeLogs, error) {
+	var (
+		logs    nodeLogs
+		out     = io.Discard
+		options []nodelog.WriterOption
+	)
+
+	// the logs are printed with the verbose output or when they are filtered
+	if c.logLevel == LogVerbose || (c.logLevel != LogSilent && c.nodeLogs.filter != nil) {
+		out = os.Stdout
+
+		prefix := c.genPrefix(logAppd)
+		if !n.primary {
+			prefix = c.genNodePrefix(n.name)
+		}
+
+		options = append(options, nodelog.WithPrefix(prefix))
+	}
+
+	if c.nodeLogs.filter != nil {
+		options = append(options, nodelog.WithFilter(*c.nodeLogs.filter))
+	}
+
+	if c.nodeLogs.saveFile {
+		path, err := c.nodeLogPath(n)
+		if err != nil {
+			return chaincmdrunner.Runner{}, nodeLogs{}, err
+		}
+
+		if logs.file, err = rotatefile.Open(path); err != nil {
+			return chaincmdrunner.Runner{}, nodeLogs{}, err
+		}
+
+		options = append(options, nodelog.WithTee(logs.file))
+	}
+
+	logs.stdout = nodelog.NewWriter(out, options...)
+	logs.stderr = nodelog.NewWriter(out, options...)
+
+	commands = commands.Copy(
+		chaincmdrunner.Stdout(logs.stdout),
+		chaincmdrunner.Stderr(logs.stderr),
+		chaincmdrunner.DaemonLogPrefix(""),
+		chaincmdrunner.CLILogPrefix(""),
+	)
+
+	return commands, logs, nil
+}
+
+// nodeStartArgs returns the arguments of the start command of a node to configure its logs.
+// The log format of the node is JSON unless it's set in the config of the validator.
+func (c *Chain) nodeStartArgs(n node) []string {
+	var args []string
+	if _, ok := n.validator.Config["log_format"]; !ok {
+		args = append(args, "--log_format", nodeLogFormat)
+	}
+
+	// the node must log the entries of the lowest level selected by the filter
+	if c.nodeLogs.filter != nil {
+		args = append(args, "--log_level", c.nodeLogs.filter.MinLevel().String())
+	}
+
+	return args
+}
+
+// nodeLogPath returns the path of the log file of a node.
+func (c *Chain) nodeLogPath(n node) (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	name := n.name
+	if name == "" {
+		name = c.app.N()
+	}
+
+	return filepath.Join(savePath, nodeLogsDir, name+".log"), nil
+}
@@ -0,0 +1,64 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestNodes(t *testing.T) {
+	c := &Chain{options: chainOptions{homePath: "/tmp/.mars"}}
+
+	conf := chainconfig.DefaultConfig()
+	conf.Validators = []chainconfig.Validator{
+		{Name: "alice", Bonded: "100000000stake"},
+		{Name: "bob", Bonded: "100000000stake"},
+		{Name: "carol", Bonded: "100000000stake", Home: "/tmp/.carol"},
+	}
+
+	nodes, err := c.nodes(conf)
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+
+	require.True(t, nodes[0].primary)
+	require.Equal(t, "alice", nodes[0].name)
+	require.Equal(t, moniker, nodes[0].moniker)
+	require.Equal(t, "/tmp/.mars", nodes[0].home)
+
+	require.False(t, nodes[1].primary)
+	require.Equal(t, "bob", nodes[1].moniker)
+	require.Equal(t, "/tmp/.mars-bob", nodes[1].home)
+
+	require.False(t, nodes[2].primary)
+	require.Equal(t, "carol", nodes[2].moniker)
+	require.Equal(t, "/tmp/.carol", nodes[2].home)
+}
+
+func TestNodesWithSharedHome(t *testing.T) {
+	c := &Chain{options: chainOptions{homePath: "/tmp/.mars"}}
+
+	conf := chainconfig.DefaultConfig()
+	conf.Validators = []chainconfig.Validator{
+		{Name: "alice", Bonded: "100000000stake"},
+		{Name: "bob", Bonded: "100000000stake", Home: "/tmp/.mars"},
+	}
+
+	_, err := c.nodes(conf)
+	require.Error(t, err)
+}
+
+func TestNodesWithDuplicateHome(t *testing.T) {
+	c := &Chain{options: chainOptions{homePath: "/tmp/.mars"}}
+
+	conf := chainconfig.DefaultConfig()
+	conf.Validators = []chainconfig.Validator{
+		{Name: "alice", Bonded: "100000000stake"},
+		{Name: "bob", Bonded: "100000000stake", Home: "/tmp/.validator"},
+		{Name: "carol", Bonded: "100000000stake", Home: "/tmp/.validator/"},
+	}
+
+	_, err := c.nodes(conf)
+	require.EqualError(t, err, `validator "carol" can't use the home of validator "bob": /tmp/.validator/`)
+}
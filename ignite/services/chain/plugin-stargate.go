@@ -45,20 +45,22 @@ func (p *stargatePlugin) Gentx(ctx context.Context, runner chaincmdrunner.Runner
 		chaincmd.GentxWithIdentity(v.Identity),
 		chaincmd.GentxWithWebsite(v.Website),
 		chaincmd.GentxWithSecurityContact(v.SecurityContact),
+		chaincmd.GentxWithNodeID(v.NodeID),
+		chaincmd.GentxWithPubKey(v.PubKey),
 	)
 }
 
-func (p *stargatePlugin) Configure(homePath string, cfg *chainconfig.Config) error {
-	if err := p.appTOML(homePath, cfg); err != nil {
+func (p *stargatePlugin) Configure(homePath string, validator chainconfig.Validator, preset chainconfig.DevPreset) error {
+	if err := p.appTOML(homePath, validator); err != nil {
 		return err
 	}
-	if err := p.clientTOML(homePath, cfg); err != nil {
+	if err := p.clientTOML(homePath, validator); err != nil {
 		return err
 	}
-	return p.configTOML(homePath, cfg)
+	return p.configTOML(homePath, validator, preset)
 }
 
-func (p *stargatePlugin) appTOML(homePath string, cfg *chainconfig.Config) error {
+func (p *stargatePlugin) appTOML(homePath string, validator chainconfig.Validator) error {
 	// TODO find a better way in order to not delete comments in the toml.yml
 	path := filepath.Join(homePath, "config/app.toml")
 	config, err := toml.LoadFile(path)
@@ -66,7 +68,6 @@ func (p *stargatePlugin) appTOML(homePath string, cfg *chainconfig.Config) error
 		return err
 	}
 
-	validator := cfg.Validators[0]
 	servers, err := validator.GetServers()
 	if err != nil {
 		return err
@@ -105,7 +106,7 @@ func (p *stargatePlugin) appTOML(homePath string, cfg *chainconfig.Config) error
 	return err
 }
 
-func (p *stargatePlugin) configTOML(homePath string, cfg *chainconfig.Config) error {
+func (p *stargatePlugin) configTOML(homePath string, validator chainconfig.Validator, preset chainconfig.DevPreset) error {
 	// TODO find a better way in order to not delete comments in the toml.yml
 	path := filepath.Join(homePath, "config/config.toml")
 	config, err := toml.LoadFile(path)
@@ -113,7 +114,6 @@ func (p *stargatePlugin) configTOML(homePath string, cfg *chainconfig.Config) er
 		return err
 	}
 
-	validator := cfg.Validators[0]
 	servers, err := validator.GetServers()
 	if err != nil {
 		return err
@@ -132,8 +132,11 @@ func (p *stargatePlugin) configTOML(homePath string, cfg *chainconfig.Config) er
 	// Set default config values
 	config.Set("mode", "validator")
 	config.Set("rpc.cors_allowed_origins", []string{"*"})
-	config.Set("consensus.timeout_commit", "1s")
-	config.Set("consensus.timeout_propose", "1s")
+
+	// Set the consensus timing of the dev preset
+	for _, o := range preset.Consensus {
+		config.Set(o.Path, o.Value)
+	}
 
 	// Update config values with the validator's Tendermint config
 	updateTomlTreeValues(config, validator.Config)
@@ -152,7 +155,7 @@ func (p *stargatePlugin) configTOML(homePath string, cfg *chainconfig.Config) er
 	return err
 }
 
-func (p *stargatePlugin) clientTOML(homePath string, cfg *chainconfig.Config) error {
+func (p *stargatePlugin) clientTOML(homePath string, validator chainconfig.Validator) error {
 	path := filepath.Join(homePath, "config/client.toml")
 	config, err := toml.LoadFile(path)
 	if os.IsNotExist(err) {
@@ -168,7 +171,7 @@ func (p *stargatePlugin) clientTOML(homePath string, cfg *chainconfig.Config) er
 	config.Set("broadcast-mode", "block")
 
 	// Update config values with the validator's client config
-	updateTomlTreeValues(config, cfg.Validators[0].Client)
+	updateTomlTreeValues(config, validator.Client)
 
 	file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0o644)
 	if err != nil {
@@ -180,14 +183,19 @@ func (p *stargatePlugin) clientTOML(homePath string, cfg *chainconfig.Config) er
 	return err
 }
 
-func (p *stargatePlugin) Start(ctx context.Context, runner chaincmdrunner.Runner, cfg *chainconfig.Config) error {
-	validator := cfg.Validators[0]
+func (p *stargatePlugin) Start(
+	ctx context.Context,
+	runner chaincmdrunner.Runner,
+	validator chainconfig.Validator,
+	args ...string,
+) error {
 	servers, err := validator.GetServers()
 	if err != nil {
 		return err
 	}
 
-	err = runner.Start(ctx, "--pruning", "nothing", "--grpc.address", servers.GRPC.Address)
+	args = append([]string{"--pruning", "nothing", "--grpc.address", servers.GRPC.Address}, args...)
+	err = runner.Start(ctx, args...)
 
 	return &CannotStartAppError{p.app.Name, err}
 }
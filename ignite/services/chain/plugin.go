@@ -16,11 +16,13 @@ type Plugin interface {
 	// Gentx returns step.Exec configuration for gentx command.
 	Gentx(context.Context, chaincmdrunner.Runner, Validator) (path string, err error)
 
-	// Configure configures config defaults.
-	Configure(string, *chainconfig.Config) error
+	// Configure configures config defaults of a validator node.
+	// The consensus values of the dev preset are set before the values of the validator config.
+	Configure(home string, validator chainconfig.Validator, preset chainconfig.DevPreset) error
 
-	// Start returns step.Exec configuration to start servers.
-	Start(context.Context, chaincmdrunner.Runner, *chainconfig.Config) error
+	// Start returns step.Exec configuration to start the servers of a validator node.
+	// The args are added to the arguments of the start command.
+	Start(ctx context.Context, runner chaincmdrunner.Runner, validator chainconfig.Validator, args ...string) error
 
 	// Home returns the blockchain node's home dir.
 	Home() string
@@ -0,0 +1,263 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/availableport"
+	"github.com/ignite/cli/ignite/pkg/xnet"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// maxPortIncrease is the max number of ports to check after a port in use.
+const maxPortIncrease = 1000
+
+// addressOverrides holds the server addresses that replace the configured
+// ones because their ports were in use when the chain was served.
+type addressOverrides struct {
+	mu sync.Mutex
+
+	// validators holds the servers of the validators by name.
+	validators map[string]chainconfig.Servers
+
+	// faucetHost is the host of the faucet server.
+	faucetHost string
+}
+
+// apply replaces the server addresses of a config with the overrides.
+func (o *addressOverrides) apply(conf *chainconfig.Config) error {
+	if o == nil {
+		return nil
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i := range conf.Validators {
+		v := &conf.Validators[i]
+		if servers, ok := o.validators[v.Name]; ok {
+			if err := v.SetServers(servers); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.faucetHost != "" {
+		conf.Faucet.Host = o.faucetHost
+		conf.Faucet.Port = 0
+	}
+
+	return nil
+}
+
+func (o *addressOverrides) setValidator(name string, servers chainconfig.Servers) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.validators == nil {
+		o.validators = make(map[string]chainconfig.Servers)
+	}
+
+	o.validators[name] = servers
+}
+
+func (o *addressOverrides) setFaucetHost(host string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.faucetHost = host
+}
+
+// portAllocator replaces addresses that have ports in use with free ones.
+type portAllocator struct {
+	// used contains the ports that are already assigned to a chain server.
+	used map[int]bool
+
+	// isAvailable checks if a port can be used.
+	isAvailable func(port int) bool
+}
+
+func newPortAllocator(conf *chainconfig.Config) (portAllocator, error) {
+	a := portAllocator{
+		used:        make(map[int]bool),
+		isAvailable: availableport.IsAvailable,
+	}
+
+	// all the configured ports are reserved to avoid assigning the
+	// port of a server that is not started yet to a different server
+	for _, v := range conf.Validators {
+		servers, err := v.GetServers()
+		if err != nil {
+			return portAllocator{}, err
+		}
+
+		for _, addr := range serverAddresses(&servers) {
+			if port, err := addressPort(*addr.value); err == nil {
+				a.used[port] = true
+			}
+		}
+	}
+
+	if conf.Faucet.Name != nil {
+		if port, err := addressPort(chainconfig.FaucetHost(conf)); err == nil {
+			a.used[port] = true
+		}
+	}
+
+	return a, nil
+}
+
+// allocate returns the address when its port is free, otherwise
+// it returns the address with the next port number that is free.
+func (a portAllocator) allocate(addr string) (string, error) {
+	port, err := addressPort(addr)
+	if err != nil {
+		return "", err
+	}
+
+	if a.isAvailable(port) {
+		return addr, nil
+	}
+
+	for inc := 1; inc <= maxPortIncrease; inc++ {
+		if a.used[port+inc] || !a.isAvailable(port+inc) {
+			continue
+		}
+
+		a.used[port+inc] = true
+
+		// the scheme of the address, e.g. tcp://, is kept in the new address
+		scheme, hostPort := xurl.SplitScheme(addr)
+		newAddr, err := xnet.IncreasePortBy(hostPort, uint64(inc))
+		if err != nil {
+			return "", err
+		}
+
+		if scheme != "" {
+			newAddr = scheme + "://" + newAddr
+		}
+
+		return newAddr, nil
+	}
+
+	return "", fmt.Errorf("no free port found for address %s", addr)
+}
+
+// allocatePorts makes sure that the servers of the chain listen at free ports.
+// The addresses of the servers with ports in use are replaced with the next free
+// ones and they are used from then on. Returns true when any address is replaced.
+func (c *Chain) allocatePorts(conf *chainconfig.Config) (changed bool, err error) {
+	a, err := newPortAllocator(conf)
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range conf.Validators {
+		servers, err := v.GetServers()
+		if err != nil {
+			return false, err
+		}
+
+		var validatorChanged bool
+		for _, addr := range serverAddresses(&servers) {
+			newAddr, err := a.allocate(*addr.value)
+			if err != nil {
+				return false, err
+			}
+
+			if newAddr != *addr.value {
+				fmt.Fprintf(
+					c.stdLog().out,
+					"🔀 Port of the %s address %s of validator %s is in use, using %s\n",
+					addr.name,
+					*addr.value,
+					v.Name,
+					newAddr,
+				)
+
+				*addr.value = newAddr
+				validatorChanged = true
+			}
+		}
+
+		if validatorChanged {
+			c.addressOverrides.setValidator(v.Name, servers)
+			changed = true
+		}
+	}
+
+	if conf.Faucet.Name != nil {
+		host := chainconfig.FaucetHost(conf)
+		newHost, err := a.allocate(host)
+		if err != nil {
+			return false, err
+		}
+
+		if newHost != host {
+			fmt.Fprintf(c.stdLog().out, "🔀 Port of the faucet address %s is in use, using %s\n", host, newHost)
+
+			c.addressOverrides.setFaucetHost(newHost)
+			changed = true
+		}
+	}
+
+	return changed, nil
+}
+
+// configureNodes updates the config files of the nodes with the current server addresses.
+func (c *Chain) configureNodes(ctx context.Context, conf *chainconfig.Config, nodes []node) error {
+	preset, err := chainconfig.ConfigDevPreset(conf)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range nodes {
+		if err := c.plugin.Configure(n.home, n.validator, preset); err != nil {
+			return err
+		}
+	}
+
+	return c.updateNodePeers(ctx, nodes)
+}
+
+type serverAddress struct {
+	name  string
+	value *string
+}
+
+// serverAddresses returns the addresses of the servers that are set.
+func serverAddresses(s *chainconfig.Servers) []serverAddress {
+	addresses := []serverAddress{
+		{"RPC", &s.RPC.Address},
+		{"P2P", &s.P2P.Address},
+		{"pprof", &s.RPC.PProfAddress},
+		{"API", &s.API.Address},
+		{"gRPC", &s.GRPC.Address},
+		{"gRPC-Web", &s.GRPCWeb.Address},
+	}
+
+	var set []serverAddress
+	for _, addr := range addresses {
+		if *addr.value != "" {
+			set = append(set, addr)
+		}
+	}
+
+	return set
+}
+
+// addressPort returns the port number of an address.
+// The address can have a scheme prefix, e.g. tcp://0.0.0.0:26657.
+func addressPort(addr string) (int, error) {
+	_, hostPort := xurl.SplitScheme(addr)
+	_, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return 0, fmt.Errorf("invalid address format %s: %w", addr, err)
+	}
+
+	return strconv.Atoi(port)
+}
@@ -0,0 +1,64 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestPortAllocatorAllocate(t *testing.T) {
+	conf := chainconfig.DefaultConfig()
+	conf.Validators = []chainconfig.Validator{{Name: "alice", Bonded: "100000000stake"}}
+
+	a, err := newPortAllocator(conf)
+	require.NoError(t, err)
+
+	// the RPC port and the next one are in use, and the one after
+	// that is free but it's reserved for another server
+	inUse := map[int]bool{26657: true, 26658: true}
+	a.used[26659] = true
+	a.isAvailable = func(port int) bool { return !inUse[port] }
+
+	addr, err := a.allocate("0.0.0.0:1317")
+	require.NoError(t, err)
+	require.Equal(t, "0.0.0.0:1317", addr)
+
+	addr, err = a.allocate("0.0.0.0:26657")
+	require.NoError(t, err)
+	require.Equal(t, "0.0.0.0:26660", addr)
+
+	// allocated ports are not assigned twice
+	addr, err = a.allocate("0.0.0.0:26657")
+	require.NoError(t, err)
+	require.Equal(t, "0.0.0.0:26661", addr)
+
+	// the scheme of the address is kept
+	addr, err = a.allocate("tcp://0.0.0.0:26657")
+	require.NoError(t, err)
+	require.Equal(t, "tcp://0.0.0.0:26662", addr)
+
+	_, err = a.allocate("invalid")
+	require.Error(t, err)
+}
+
+func TestAddressOverridesApply(t *testing.T) {
+	conf := chainconfig.DefaultConfig()
+	conf.Validators = []chainconfig.Validator{{Name: "alice", Bonded: "100000000stake"}}
+	conf.Faucet.Port = 4500
+
+	servers, err := conf.Validators[0].GetServers()
+	require.NoError(t, err)
+	servers.RPC.Address = "0.0.0.0:26660"
+
+	var o addressOverrides
+	o.setValidator("alice", servers)
+	o.setFaucetHost("0.0.0.0:4501")
+	require.NoError(t, o.apply(conf))
+
+	servers, err = conf.Validators[0].GetServers()
+	require.NoError(t, err)
+	require.Equal(t, "0.0.0.0:26660", servers.RPC.Address)
+	require.Equal(t, "0.0.0.0:4501", chainconfig.FaucetHost(conf))
+}
@@ -0,0 +1,665 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/checksum"
+	"github.com/ignite/cli/ignite/pkg/cliui/entrywriter"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/pkg/gomodule"
+	"github.com/ignite/cli/ignite/pkg/ociimage"
+	"github.com/ignite/cli/ignite/pkg/sbom"
+	"github.com/ignite/cli/ignite/pkg/tarball"
+	igniteversion "github.com/ignite/cli/ignite/version"
+)
+
+const (
+	releaseDir          = "release"
+	releaseChecksumKey  = "release_checksum"
+	releaseManifestFile = "release_manifest.json"
+	releaseSBOMFile     = "release_sbom.cdx.json"
+
+	// releaseImageOS is the only OS of the release targets with an OCI image.
+	releaseImageOS = "linux"
+
+	// releaseImageBinDir is the dir of the image that contains the binary.
+	releaseImageBinDir = "/usr/local/bin"
+
+	// releaseImageHome is the home dir of the user that runs the binary in the image.
+	releaseImageHome = "/root"
+
+	// envSourceDateEpoch is the env var with the timestamp used for the release files,
+	// as defined by https://reproducible-builds.org/specs/source-date-epoch.
+	envSourceDateEpoch = "SOURCE_DATE_EPOCH"
+)
+
+var releaseSummaryHeader = []string{"target", "status", "duration", "binary sha256"}
+
+// ReleaseManifest describes how the binaries of a release were built,
+// so they can be rebuilt and verified independently.
+type ReleaseManifest struct {
+	Name            string            `json:"name"`
+	Version         string            `json:"version"`
+	Commit          string            `json:"commit"`
+	SourceDateEpoch int64             `json:"source_date_epoch"`
+	GoVersion       string            `json:"go_version"`
+	BuildFlags      []string          `json:"build_flags"`
+	LDFlags         []string          `json:"ldflags"`
+	Env             []string          `json:"env,omitempty"`
+	Modules         []ReleaseModule   `json:"modules"`
+	Artifacts       []ReleaseArtifact `json:"artifacts"`
+}
+
+// ReleaseModule is a module required by the go.mod of the chain.
+type ReleaseModule struct {
+	Path     string `json:"path"`
+	Version  string `json:"version"`
+	Indirect bool   `json:"indirect,omitempty"`
+
+	// Replace is the module that replaces the required module, e.g. path@version.
+	Replace string `json:"replace,omitempty"`
+}
+
+// ReleaseArtifact is the tarball of the binary built for a target.
+type ReleaseArtifact struct {
+	Target       string `json:"target"`
+	File         string `json:"file"`
+	SHA256       string `json:"sha256"`
+	Binary       string `json:"binary"`
+	BinarySHA256 string `json:"binary_sha256"`
+
+	// Image is the tarball of the OCI image layout created for the target, when enabled.
+	Image string `json:"image,omitempty"`
+
+	// ImageDigest is the digest of the manifest of the OCI image.
+	ImageDigest string `json:"image_digest,omitempty"`
+}
+
+// ReleaseOption configures the release build.
+type ReleaseOption func(*releaseOptions)
+
+type releaseOptions struct {
+	jobs int
+	oci  bool
+}
+
+// ReleaseJobs sets the number of release targets that are built concurrently.
+// By default half of the CPUs are used, as each build uses all of them too.
+func ReleaseJobs(jobs int) ReleaseOption {
+	return func(o *releaseOptions) {
+		o.jobs = jobs
+	}
+}
+
+// ReleaseOCI enables the creation of an OCI image for each linux release target.
+// The images are written as tarballs of OCI image layouts, so they can be pushed
+// to a container registry with any OCI compatible tool.
+func ReleaseOCI() ReleaseOption {
+	return func(o *releaseOptions) {
+		o.oci = true
+	}
+}
+
+// ReleaseTargetError is returned when the binary of a release target can't be built.
+type ReleaseTargetError struct {
+	Target string
+	Err    error
+}
+
+func (e *ReleaseTargetError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Target, e.Err)
+}
+
+func (e *ReleaseTargetError) Unwrap() error {
+	return e.Err
+}
+
+// ReleaseError is returned when the binaries of some of the release targets can't be built.
+type ReleaseError struct {
+	Errors []*ReleaseTargetError
+}
+
+func (e *ReleaseError) Error() string {
+	targets := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		targets[i] = err.Target
+	}
+
+	return fmt.Sprintf("cannot build the release targets: %s", strings.Join(targets, ", "))
+}
+
+// releaseBuild holds the settings shared by the builds of the release targets.
+type releaseBuild struct {
+	binary      string
+	mainPath    string
+	releasePath string
+	prefix      string
+	buildFlags  []string
+	buildEnv    []string
+	sourceDate  time.Time
+	oci         bool
+}
+
+// releaseTargetResult is the result of the build of a release target.
+type releaseTargetResult struct {
+	target   string
+	artifact ReleaseArtifact
+	duration time.Duration
+	log      bytes.Buffer
+	err      error
+}
+
+// BuildRelease builds binaries for a release. targets is a list
+// of GOOS:GOARCH when provided. It defaults to your system when no targets provided.
+// prefix is used as prefix to tarballs containing each target.
+// The builds are reproducible and the release includes a manifest that describes how
+// the binaries were built and a CycloneDX SBOM of the chain's Go modules.
+// The targets are built concurrently and a failing target doesn't stop the build of
+// the other ones, the failures are returned at the end as a ReleaseError.
+func (c *Chain) BuildRelease(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	output,
+	prefix string,
+	targets []string,
+	options ...ReleaseOption,
+) (releasePath string, err error) {
+	o := releaseOptions{}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if prefix == "" {
+		prefix = c.app.Name
+	}
+	if len(targets) == 0 {
+		targets = []string{gocmd.BuildTarget(runtime.GOOS, runtime.GOARCH)}
+	}
+
+	start := c.phaseStarted(events.PhaseBuild, events.Message("release"))
+	defer func() { c.phaseDone(events.PhaseBuild, start, err, events.Message(releasePath)) }()
+
+	// prepare for build.
+	if err := c.setup(); err != nil {
+		return "", err
+	}
+
+	buildFlags, buildEnv, err := c.preBuild(ctx, cacheStorage)
+	if err != nil {
+		return "", err
+	}
+
+	rb := releaseBuild{
+		prefix:     prefix,
+		buildFlags: releaseBuildFlags(buildFlags),
+		buildEnv:   buildEnv,
+		oci:        o.oci,
+	}
+
+	if rb.sourceDate, err = c.sourceDate(); err != nil {
+		return "", err
+	}
+
+	if rb.binary, err = c.Binary(); err != nil {
+		return "", err
+	}
+
+	if rb.mainPath, err = c.discoverMain(c.app.Path); err != nil {
+		return "", err
+	}
+
+	manifest, err := c.newReleaseManifest(ctx, rb.buildFlags, rb.buildEnv, rb.sourceDate)
+	if err != nil {
+		return "", err
+	}
+
+	releasePath = output
+	if releasePath == "" {
+		releasePath = filepath.Join(c.app.Path, releaseDir)
+		// reset the release dir.
+		if err := os.RemoveAll(releasePath); err != nil {
+			return "", err
+		}
+	}
+
+	if err := os.MkdirAll(releasePath, 0o755); err != nil {
+		return "", err
+	}
+
+	rb.releasePath = releasePath
+
+	results := c.buildReleaseTargets(ctx, rb, targets, releaseJobs(o.jobs, len(targets)))
+
+	if err := c.printReleaseSummary(results); err != nil {
+		return "", err
+	}
+
+	var releaseErr ReleaseError
+	for _, r := range results {
+		if r.err != nil {
+			releaseErr.Errors = append(releaseErr.Errors, &ReleaseTargetError{r.target, r.err})
+			continue
+		}
+
+		manifest.Artifacts = append(manifest.Artifacts, r.artifact)
+	}
+
+	if len(releaseErr.Errors) > 0 {
+		return releasePath, &releaseErr
+	}
+
+	if err := writeReleaseJSON(filepath.Join(releasePath, releaseManifestFile), manifest); err != nil {
+		return "", err
+	}
+
+	bom, err := c.releaseSBOM(ctx, rb.sourceDate)
+	if err != nil {
+		return "", err
+	}
+
+	if err := writeReleaseJSON(filepath.Join(releasePath, releaseSBOMFile), bom); err != nil {
+		return "", err
+	}
+
+	checksumPath := filepath.Join(releasePath, releaseChecksumKey)
+
+	// create a checksum.txt and return with the path to release dir.
+	return releasePath, checksum.Sum(releasePath, checksumPath)
+}
+
+// releaseJobs returns the number of release targets to build concurrently.
+func releaseJobs(jobs, targets int) int {
+	if jobs <= 0 {
+		jobs = runtime.NumCPU() / 2
+	}
+
+	if jobs > targets {
+		jobs = targets
+	}
+
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	return jobs
+}
+
+// buildReleaseTargets builds the release targets with a pool of workers.
+// The results are returned in the order of the targets.
+func (c *Chain) buildReleaseTargets(ctx context.Context, rb releaseBuild, targets []string, jobs int) []*releaseTargetResult {
+	var (
+		results = make([]*releaseTargetResult, len(targets))
+		queue   = make(chan int)
+		logMu   sync.Mutex
+		wg      sync.WaitGroup
+	)
+
+	for w := 0; w < jobs; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range queue {
+				r := &releaseTargetResult{target: targets[i]}
+				start := time.Now()
+				r.artifact, r.err = c.buildReleaseTarget(ctx, rb, targets[i], &r.log)
+				r.duration = time.Since(start)
+				results[i] = r
+
+				// the logs of each target are printed at once to not interleave them
+				logMu.Lock()
+				c.printReleaseTargetResult(r)
+				logMu.Unlock()
+			}
+		}()
+	}
+
+	for i := range targets {
+		queue <- i
+	}
+
+	close(queue)
+	wg.Wait()
+
+	return results
+}
+
+// buildReleaseTarget builds the binary of a release target, tarballs it and saves it under the release dir.
+// The output of the build is written to log.
+func (c *Chain) buildReleaseTarget(ctx context.Context, rb releaseBuild, target string, log io.Writer) (ReleaseArtifact, error) {
+	goos, goarch, err := gocmd.ParseTarget(target)
+	if err != nil {
+		return ReleaseArtifact{}, err
+	}
+
+	out, err := os.MkdirTemp("", "")
+	if err != nil {
+		return ReleaseArtifact{}, err
+	}
+	defer os.RemoveAll(out)
+
+	targetEnv := append([]string{
+		cmdrunner.Env(gocmd.EnvGOOS, goos),
+		cmdrunner.Env(gocmd.EnvGOARCH, goarch),
+	}, rb.buildEnv...)
+
+	buildOptions := []exec.Option{
+		exec.StepOption(step.Env(targetEnv...)),
+		exec.StepOption(step.Stdout(log)),
+		exec.StepOption(step.Stderr(log)),
+	}
+
+	if err := gocmd.BuildPath(ctx, out, rb.binary, rb.mainPath, rb.buildFlags, buildOptions...); err != nil {
+		return ReleaseArtifact{}, err
+	}
+
+	tarName := fmt.Sprintf("%s_%s_%s.tar.gz", rb.prefix, goos, goarch)
+	tarPath := filepath.Join(rb.releasePath, tarName)
+
+	if err := createReleaseArchive(tarPath, out, rb.sourceDate, tarball.Create); err != nil {
+		return ReleaseArtifact{}, err
+	}
+
+	artifact := ReleaseArtifact{
+		Target: gocmd.BuildTarget(goos, goarch),
+		File:   tarName,
+		Binary: rb.binary,
+	}
+
+	if artifact.SHA256, err = checksum.File(tarPath); err != nil {
+		return ReleaseArtifact{}, err
+	}
+
+	if artifact.BinarySHA256, err = checksum.File(filepath.Join(out, rb.binary)); err != nil {
+		return ReleaseArtifact{}, err
+	}
+
+	if rb.oci && goos == releaseImageOS {
+		artifact.Image, artifact.ImageDigest, err = c.createReleaseImage(rb, goarch, filepath.Join(out, rb.binary))
+		if err != nil {
+			return ReleaseArtifact{}, err
+		}
+	}
+
+	return artifact, nil
+}
+
+// createReleaseImage creates the OCI image of a linux release target and saves the tarball
+// of its image layout under the release dir. The name and the digest of the image are returned.
+func (c *Chain) createReleaseImage(rb releaseBuild, goarch, binaryPath string) (name, digest string, err error) {
+	layoutPath, err := os.MkdirTemp("", "")
+	if err != nil {
+		return "", "", err
+	}
+	defer os.RemoveAll(layoutPath)
+
+	desc, err := ociimage.WriteLayout(layoutPath, c.releaseImage(rb, goarch, binaryPath))
+	if err != nil {
+		return "", "", err
+	}
+
+	name = fmt.Sprintf("%s_%s_%s.oci.tar", rb.prefix, releaseImageOS, goarch)
+	if err := createReleaseArchive(filepath.Join(rb.releasePath, name), layoutPath, rb.sourceDate, tarball.Write); err != nil {
+		return "", "", err
+	}
+
+	return name, desc.Digest, nil
+}
+
+// releaseImage returns the OCI image of a linux release target. The image has a layer with
+// a minimal root filesystem and a layer with the binary, which is started by the entrypoint.
+func (c *Chain) releaseImage(rb releaseBuild, goarch, binaryPath string) ociimage.Image {
+	labels := map[string]string{
+		"org.opencontainers.image.title":   c.app.Name,
+		"org.opencontainers.image.created": rb.sourceDate.UTC().Format(time.RFC3339),
+	}
+
+	name := "latest"
+	if c.sourceVersion.tag != "" {
+		name = c.sourceVersion.tag
+		labels["org.opencontainers.image.version"] = c.sourceVersion.tag
+	}
+
+	if c.sourceVersion.hash != "" {
+		labels["org.opencontainers.image.revision"] = c.sourceVersion.hash
+	}
+
+	binaryImagePath := path.Join(releaseImageBinDir, rb.binary)
+
+	return ociimage.Image{
+		Name:         name,
+		OS:           releaseImageOS,
+		Architecture: goarch,
+		Created:      rb.sourceDate,
+		Env: []string{
+			"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin",
+			"HOME=" + releaseImageHome,
+		},
+		Entrypoint:   []string{binaryImagePath, "start"},
+		WorkingDir:   releaseImageHome,
+		ExposedPorts: releaseImagePorts(),
+		Labels:       labels,
+		Layers: []ociimage.Layer{
+			{
+				CreatedBy: "ignite: root filesystem",
+				Files: []ociimage.File{
+					{Path: "/etc/passwd", Mode: 0o644, Data: []byte("root:x:0:0:root:/root:/sbin/nologin\n")},
+					{Path: "/etc/group", Mode: 0o644, Data: []byte("root:x:0:\n")},
+					{Path: "/etc/nsswitch.conf", Mode: 0o644, Data: []byte("hosts: files dns\n")},
+					{Path: releaseImageHome, Mode: fs.ModeDir | 0o700},
+					{Path: "/tmp", Mode: fs.ModeDir | fs.ModeSticky | 0o777},
+					{Path: releaseImageBinDir, Mode: fs.ModeDir | 0o755},
+				},
+			},
+			{
+				CreatedBy: fmt.Sprintf("ignite: %s binary", rb.binary),
+				Files: []ociimage.File{
+					{Path: binaryImagePath, Mode: 0o755, Source: binaryPath},
+				},
+			},
+		},
+	}
+}
+
+// releaseImagePorts returns the ports exposed by the image, which are the ports of the default
+// addresses of the P2P, RPC, API, gRPC and gRPC-Web servers of a validator.
+func releaseImagePorts() []string {
+	servers := chainconfig.DefaultServers()
+	addresses := []string{
+		servers.P2P.Address,
+		servers.RPC.Address,
+		servers.API.Address,
+		servers.GRPC.Address,
+		servers.GRPCWeb.Address,
+	}
+
+	var ports []string
+	for _, addr := range addresses {
+		if _, port, err := net.SplitHostPort(addr); err == nil {
+			ports = append(ports, port+"/tcp")
+		}
+	}
+
+	return ports
+}
+
+func (c *Chain) printReleaseTargetResult(r *releaseTargetResult) {
+	duration := r.duration.Round(time.Second)
+
+	if r.err != nil {
+		fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(fmt.Sprintf("❌ %s failed after %s: %s", r.target, duration, r.err)))
+	} else {
+		fmt.Fprintf(c.stdLog().out, "📦 %s built in %s\n", r.target, duration)
+	}
+
+	if r.log.Len() > 0 {
+		fmt.Fprintln(c.stdLog().out, strings.TrimRight(r.log.String(), "\n"))
+	}
+}
+
+func (c *Chain) printReleaseSummary(results []*releaseTargetResult) error {
+	entries := make([][]string, len(results))
+	for i, r := range results {
+		status, binarySum := "ok", r.artifact.BinarySHA256
+		if r.err != nil {
+			status, binarySum = "failed", entrywriter.None
+		}
+
+		entries[i] = []string{r.target, status, r.duration.Round(time.Second).String(), binarySum}
+	}
+
+	fmt.Fprintln(c.stdLog().out)
+
+	return entrywriter.Write(c.stdLog().out, releaseSummaryHeader, entries...)
+}
+
+// releaseBuildFlags returns the build flags for reproducible release builds.
+// The binaries don't include the file system paths nor the VCS state of the source.
+func releaseBuildFlags(buildFlags []string) []string {
+	flags := append([]string{}, buildFlags...)
+
+	hasTrimpath := false
+	for _, f := range flags {
+		if f == gocmd.FlagTrimpath {
+			hasTrimpath = true
+		}
+	}
+
+	if !hasTrimpath {
+		flags = append(flags, gocmd.FlagTrimpath)
+	}
+
+	return append(flags, gocmd.FlagBuildvcs+"=false")
+}
+
+// sourceDate returns the timestamp used for the release files.
+// The timestamp is read from SOURCE_DATE_EPOCH when it's set, otherwise the
+// commit time of the source is used, or the Unix epoch when there is no commit.
+func (c *Chain) sourceDate() (time.Time, error) {
+	if epoch := os.Getenv(envSourceDateEpoch); epoch != "" {
+		sec, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(err, "invalid %s", envSourceDateEpoch)
+		}
+
+		return time.Unix(sec, 0).UTC(), nil
+	}
+
+	if !c.sourceVersion.time.IsZero() {
+		return c.sourceVersion.time.UTC(), nil
+	}
+
+	return time.Unix(0, 0).UTC(), nil
+}
+
+// newReleaseManifest creates the manifest of a release without artifacts.
+func (c *Chain) newReleaseManifest(ctx context.Context, buildFlags, buildEnv []string, sourceDate time.Time) (*ReleaseManifest, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	goVersion, err := gocmd.Env(ctx, gocmd.EnvGOVERSION)
+	if err != nil {
+		return nil, err
+	}
+
+	modFile, err := gomodule.ParseAt(c.app.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	replaces := make(map[string]string)
+	for _, r := range modFile.Replace {
+		replaces[r.Old.Path] = r.New.String()
+	}
+
+	modules := make([]ReleaseModule, len(modFile.Require))
+	for i, r := range modFile.Require {
+		modules[i] = ReleaseModule{
+			Path:     r.Mod.Path,
+			Version:  r.Mod.Version,
+			Indirect: r.Indirect,
+			Replace:  replaces[r.Mod.Path],
+		}
+	}
+
+	return &ReleaseManifest{
+		Name:            c.app.Name,
+		Version:         c.sourceVersion.tag,
+		Commit:          c.sourceVersion.hash,
+		SourceDateEpoch: sourceDate.Unix(),
+		GoVersion:       goVersion,
+		BuildFlags:      buildFlags,
+		LDFlags:         c.ldFlags(conf, chainID),
+		Env:             buildEnv,
+		Modules:         modules,
+	}, nil
+}
+
+// releaseSBOM creates the CycloneDX SBOM of the chain from its resolved Go module graph.
+func (c *Chain) releaseSBOM(ctx context.Context, sourceDate time.Time) (*sbom.BOM, error) {
+	modules, err := gomodule.BuildList(ctx, c.app.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	graph, err := gomodule.Graph(ctx, c.app.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return sbom.NewCycloneDX(c.sourceVersion.tag, modules, graph, sourceDate, sbom.WithTool("ignite", igniteversion.Version))
+}
+
+// createReleaseArchive saves the files of a dir into an archive created with the archive func.
+func createReleaseArchive(file, dir string, mtime time.Time, archive func(io.Writer, string, time.Time) error) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := archive(f, dir, mtime); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+func writeReleaseJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,136 @@
+package chain
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseBuildFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		buildFlags []string
+		want       []string
+	}{
+		{
+			name:       "trimpath is added",
+			buildFlags: []string{"-mod", "readonly"},
+			want:       []string{"-mod", "readonly", "-trimpath", "-buildvcs=false"},
+		},
+		{
+			name:       "trimpath is not repeated",
+			buildFlags: []string{"-mod", "readonly", "-trimpath"},
+			want:       []string{"-mod", "readonly", "-trimpath", "-buildvcs=false"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, releaseBuildFlags(tt.buildFlags))
+		})
+	}
+}
+
+func TestSourceDate(t *testing.T) {
+	commitTime := time.Date(2022, 9, 30, 10, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	tests := []struct {
+		name            string
+		sourceDateEpoch string
+		commitTime      time.Time
+		want            time.Time
+		err             bool
+	}{
+		{
+			name: "unix epoch without commit",
+			want: time.Unix(0, 0).UTC(),
+		},
+		{
+			name:       "commit time",
+			commitTime: commitTime,
+			want:       commitTime.UTC(),
+		},
+		{
+			name:            "source date epoch",
+			sourceDateEpoch: "1660000000",
+			commitTime:      commitTime,
+			want:            time.Unix(1660000000, 0).UTC(),
+		},
+		{
+			name:            "invalid source date epoch",
+			sourceDateEpoch: "yesterday",
+			err:             true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(envSourceDateEpoch, tt.sourceDateEpoch)
+
+			c := &Chain{sourceVersion: version{time: tt.commitTime}}
+
+			got, err := c.sourceDate()
+
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReleaseJobs(t *testing.T) {
+	defaultJobs := runtime.NumCPU() / 2
+	if defaultJobs < 1 {
+		defaultJobs = 1
+	}
+
+	tests := []struct {
+		name    string
+		jobs    int
+		targets int
+		want    int
+	}{
+		{
+			name:    "default",
+			targets: 100,
+			want:    defaultJobs,
+		},
+		{
+			name:    "custom",
+			jobs:    3,
+			targets: 6,
+			want:    3,
+		},
+		{
+			name:    "not more jobs than targets",
+			jobs:    8,
+			targets: 2,
+			want:    2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, releaseJobs(tt.jobs, tt.targets))
+		})
+	}
+}
+
+func TestReleaseError(t *testing.T) {
+	err := &ReleaseError{
+		Errors: []*ReleaseTargetError{
+			{Target: "linux:arm64", Err: errors.New("exit status 2")},
+			{Target: "windows:amd64", Err: errors.New("exit status 1")},
+		},
+	}
+
+	require.EqualError(t, err, "cannot build the release targets: linux:arm64, windows:amd64")
+	require.EqualError(t, err.Errors[0], "linux:arm64: exit status 2")
+}
+
+func TestReleaseImagePorts(t *testing.T) {
+	require.Equal(t, []string{"26656/tcp", "26657/tcp", "1317/tcp", "9090/tcp", "9091/tcp"}, releaseImagePorts())
+}
@@ -0,0 +1,137 @@
+package chain
+
+import (
+	"context"
+	"os"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+var (
+	// ErrNoRecordedTxs is returned when there are no recorded transactions to replay.
+	ErrNoRecordedTxs = errors.New("no transactions were recorded before the last state reset")
+
+	// ErrUnknownTxSigner is returned when the signer of a recorded transaction is unknown
+	// because the type of its public key is not supported.
+	ErrUnknownTxSigner = errors.New("the signer of the transaction is unknown")
+)
+
+type replayOptions struct {
+	nodeAddress string
+}
+
+// ReplayOption provides options for the replay of the recorded transactions.
+type ReplayOption func(*replayOptions)
+
+// ReplayNodeAddress sets the address of the node where the transactions are broadcasted.
+// By default the transactions are broadcasted to the node of the first validator.
+func ReplayNodeAddress(addr string) ReplayOption {
+	return func(o *replayOptions) {
+		o.nodeAddress = addr
+	}
+}
+
+// ReplayResult is the result of the replay of a recorded transaction.
+type ReplayResult struct {
+	// Tx is the recorded transaction.
+	Tx RecordedTx
+
+	// Hash is the hash of the replayed transaction.
+	Hash string
+
+	// Err is the reason why the transaction can't be replayed.
+	Err error
+}
+
+// Replay broadcasts again the transactions that were recorded before the last state reset.
+// The transactions are signed again by the accounts of the chain's keyring and they are
+// broadcasted in the order they were delivered. The replay continues when a transaction
+// fails and the error is reported in the transaction result.
+func (c *Chain) Replay(ctx context.Context, options ...ReplayOption) ([]ReplayResult, error) {
+	var o replayOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	path, err := c.txSessionPath(lastTxSessionFile)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := readRecordedTxs(path)
+	if os.IsNotExist(err) || (err == nil && len(txs) == 0) {
+		return nil, ErrNoRecordedTxs
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := recordedTxsAddressPrefix(txs)
+	if err != nil {
+		return nil, err
+	}
+
+	var client cosmosclient.Client
+	if o.nodeAddress != "" {
+		client, err = c.client(ctx, o.nodeAddress, prefix)
+	} else {
+		conf, confErr := c.Config()
+		if confErr != nil {
+			return nil, confErr
+		}
+
+		client, err = c.localClient(ctx, conf, prefix)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ReplayResult, len(txs))
+	for i, tx := range txs {
+		results[i].Tx = tx
+
+		if tx.Signer == "" {
+			results[i].Err = ErrUnknownTxSigner
+			continue
+		}
+
+		account, err := client.Account(tx.Signer)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		msgs := make([]sdktypes.Msg, len(tx.Messages))
+		for j, msg := range tx.Messages {
+			msgs[j] = cosmosclient.NewRawMsg(msg.TypeURL, msg.Value)
+		}
+
+		resp, err := client.BroadcastTx(ctx, account, msgs...)
+		if errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		results[i].Hash = resp.TxHash
+	}
+
+	return results, nil
+}
+
+// recordedTxsAddressPrefix returns the address prefix of the signers of the recorded transactions.
+func recordedTxsAddressPrefix(txs []RecordedTx) (string, error) {
+	for _, tx := range txs {
+		if tx.Signer != "" {
+			return cosmosutil.GetAddressPrefix(tx.Signer)
+		}
+	}
+
+	return "", ErrUnknownTxSigner
+}
@@ -2,6 +2,7 @@ package chain
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -9,6 +10,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/otiai10/copy"
 	"github.com/pkg/errors"
@@ -19,7 +21,9 @@ import (
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
 	"github.com/ignite/cli/ignite/pkg/dirchange"
+	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/localfs"
+	"github.com/ignite/cli/ignite/pkg/nodelog"
 	"github.com/ignite/cli/ignite/pkg/xexec"
 	"github.com/ignite/cli/ignite/pkg/xfilepath"
 	"github.com/ignite/cli/ignite/pkg/xhttp"
@@ -55,9 +59,13 @@ var (
 )
 
 type serveOptions struct {
-	forceReset bool
-	resetOnce  bool
-	skipProto  bool
+	forceReset   bool
+	resetOnce    bool
+	skipProto    bool
+	fromSnapshot string
+	fromExport   string
+	forkOptions  []ForkOption
+	nodeLogs     nodeLogOptions
 }
 
 func newServeOption() serveOptions {
@@ -91,6 +99,37 @@ func ServeSkipProto() ServeOption {
 	}
 }
 
+// ServeFromSnapshot allows to restore the state saved in a snapshot when the chain is served
+func ServeFromSnapshot(name string) ServeOption {
+	return func(c *serveOptions) {
+		c.fromSnapshot = name
+	}
+}
+
+// ServeFromExport allows to fork the state exported from another network when the chain is served.
+// The chain is served with a single validator that holds the voting power of the network.
+func ServeFromExport(exportedGenesisPath string, options ...ForkOption) ServeOption {
+	return func(c *serveOptions) {
+		c.fromExport = exportedGenesisPath
+		c.forkOptions = options
+	}
+}
+
+// ServeLogFilter allows to print the logs of the nodes that are selected by a filter.
+// The logs are printed even when the output is not verbose.
+func ServeLogFilter(filter nodelog.Filter) ServeOption {
+	return func(c *serveOptions) {
+		c.nodeLogs.filter = &filter
+	}
+}
+
+// ServeLogFile allows to save the logs of the nodes in rotating files in the chain save path.
+func ServeLogFile() ServeOption {
+	return func(c *serveOptions) {
+		c.nodeLogs.saveFile = true
+	}
+}
+
 // Serve serves an app.
 func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options ...ServeOption) error {
 	serveOptions := newServeOption()
@@ -100,6 +139,8 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 		apply(&serveOptions)
 	}
 
+	c.nodeLogs = serveOptions.nodeLogs
+
 	// initial checks and setup.
 	if err := c.setup(); err != nil {
 		return err
@@ -114,6 +155,31 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 		return err
 	}
 
+	// make sure that the config file of the profile exists
+	if profilePath := c.ConfigProfilePath(); profilePath != "" {
+		if _, err := os.Stat(profilePath); err != nil {
+			return err
+		}
+	}
+
+	// make sure that the snapshot to restore exists
+	if serveOptions.fromSnapshot != "" {
+		if _, err := c.Snapshot(serveOptions.fromSnapshot); err != nil {
+			return err
+		}
+	}
+
+	// make sure that the exported genesis to fork exists
+	if serveOptions.fromExport != "" {
+		if serveOptions.fromSnapshot != "" {
+			return errors.New("a chain can't be served from a snapshot and from an exported genesis at the same time")
+		}
+
+		if _, err := os.Stat(serveOptions.fromExport); err != nil {
+			return err
+		}
+	}
+
 	// start serving components.
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -142,12 +208,26 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 				)
 				serveCtx, c.serveCancel = context.WithCancel(ctx)
 
+				// get the actions requested through the control API
+				resetRequested, buildRequested := c.control.takeRequests()
+
 				// determine if the chain should reset the state
-				shouldReset := serveOptions.forceReset || serveOptions.resetOnce
+				shouldReset := serveOptions.forceReset || serveOptions.resetOnce || resetRequested
 
 				// serve the app.
-				err = c.serve(serveCtx, cacheStorage, shouldReset, serveOptions.skipProto)
+				err = c.serve(
+					serveCtx,
+					cacheStorage,
+					shouldReset,
+					buildRequested,
+					serveOptions.skipProto,
+					serveOptions.fromSnapshot,
+					serveOptions.fromExport,
+					serveOptions.forkOptions,
+				)
 				serveOptions.resetOnce = false
+				serveOptions.fromSnapshot = ""
+				serveOptions.fromExport = ""
 
 				switch {
 				case err == nil:
@@ -155,23 +235,30 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 					// If the app has been served, we save the genesis state
 					if c.served {
 						c.served = false
+						c.control.setRunning(false)
 
 						fmt.Fprintln(c.stdLog().out, "💿 Saving genesis state...")
 
-						// If serve has been stopped, save the genesis state
-						if err := c.saveChainState(context.TODO(), commands); err != nil {
-							fmt.Fprint(c.stdLog().err, err.Error())
+						genesisPath, err := c.exportedGenesisPath()
+						if err != nil {
+							fmt.Fprintln(c.stdLog().err, err.Error())
 							return err
 						}
 
-						genesisPath, err := c.exportedGenesisPath()
+						// If serve has been stopped, save the genesis state
+						start := c.phaseStarted(events.PhaseExport)
+						err = c.saveChainState(context.TODO(), commands)
+						c.phaseDone(events.PhaseExport, start, err, events.Message(genesisPath))
+						c.control.exported(genesisPath, err)
 						if err != nil {
-							fmt.Fprintln(c.stdLog().err, err.Error())
+							fmt.Fprint(c.stdLog().err, err.Error())
 							return err
 						}
 						fmt.Fprintf(c.stdLog().out, "💿 Genesis state saved in %s\n", genesisPath)
 					}
 				case errors.As(err, &buildErr):
+					c.control.setBuildError(err)
+
 					fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(err.Error()))
 
 					var validationErr *chainconfig.ValidationError
@@ -190,7 +277,8 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 					// We suggest the user to eventually reset the app state
 					if parsedErr == "" {
 						fmt.Fprintf(c.stdLog().out, "%s %s\n", infoColor(`Blockchain failed to start.
-If the new code is no longer compatible with the saved state, you can reset the database by launching:`), "ignite chain serve --reset-once")
+If the new code is no longer compatible with the saved state, you can add a genesis migration in the genesis_migrations directory
+or reset the database by launching:`), "ignite chain serve --reset-once")
 
 						return fmt.Errorf("cannot run %s", startErr.AppName)
 					}
@@ -209,6 +297,17 @@ If the new code is no longer compatible with the saved state, you can reset the
 		return c.watchAppBackend(ctx)
 	})
 
+	// routine to run the control API
+	g.Go(func() error {
+		// config errors are reported when the chain is served
+		conf, err := c.Config()
+		if err != nil {
+			return nil
+		}
+
+		return c.runControlServer(ctx, conf)
+	})
+
 	return g.Wait()
 }
 
@@ -235,11 +334,14 @@ func (c *Chain) refreshServe() {
 	c.serveRefresher <- struct{}{}
 }
 
+// appSourceChecksumPaths returns the paths of the app that are considered as its source by serve.
+// The genesis migrations are included so that a new migration is applied to the exported genesis.
+func appSourceChecksumPaths() []string {
+	return append([]string{genesisMigrationsDir}, appBackendSourceWatchPaths...)
+}
+
 func (c *Chain) watchAppBackend(ctx context.Context) error {
-	watchPaths := appBackendSourceWatchPaths
-	if c.ConfigPath() != "" {
-		watchPaths = append(watchPaths, c.ConfigPath())
-	}
+	watchPaths := append(appSourceChecksumPaths(), c.configPaths()...)
 
 	return localfs.Watch(
 		ctx,
@@ -255,13 +357,24 @@ func (c *Chain) watchAppBackend(ctx context.Context) error {
 // serve performs the operations to serve the blockchain: build, init and start
 // if the chain is already initialized and the file didn't changed, the app is directly started
 // if the files changed, the state is imported
-func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceReset, skipProto bool) error {
+// if a snapshot name is specified, the state saved in the snapshot is restored
+// if an exported genesis is specified, the state of the exported network is forked
+// if forceBuild is set, the app is built even when the source didn't change
+func (c *Chain) serve(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	forceReset, forceBuild, skipProto bool,
+	fromSnapshot, fromExport string,
+	forkOptions []ForkOption,
+) error {
 	conf, err := c.Config()
 	if err != nil {
-		return &CannotBuildAppError{err}
+		err = &CannotBuildAppError{err}
+		c.phaseDone(events.PhaseBuild, c.phaseStarted(events.PhaseBuild), err)
+		return err
 	}
 
-	commands, err := c.Commands(ctx)
+	nodes, err := c.nodes(conf)
 	if err != nil {
 		return err
 	}
@@ -279,8 +392,8 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 	}
 	if isInit {
 		configModified := false
-		if c.ConfigPath() != "" {
-			configModified, err = dirchange.HasDirChecksumChanged(dirCache, configChecksumKey, c.app.Path, c.ConfigPath())
+		if configPaths := c.configPaths(); len(configPaths) > 0 {
+			configModified, err = dirchange.HasDirChecksumChanged(dirCache, configChecksumKey, c.app.Path, configPaths...)
 			if err != nil {
 				return err
 			}
@@ -295,7 +408,7 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 
 	// check if source has been modified since last serve
 	// if the state must not be reset but the source has changed, we rebuild the chain and import the exported state
-	sourceModified, err := dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, c.app.Path, appBackendSourceWatchPaths...)
+	sourceModified, err := dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, c.app.Path, appSourceChecksumPaths()...)
 	if err != nil {
 		return err
 	}
@@ -334,44 +447,121 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 	}
 
 	// build phase
-	if !isInit || appModified {
+	if !isInit || appModified || forceBuild {
 		// build the blockchain app
 		if err := c.build(ctx, cacheStorage, "", skipProto); err != nil {
 			return err
 		}
+
+		c.control.setBuildError(nil)
+	}
+
+	// make sure that the chain servers don't use ports that are already in use
+	portsChanged, err := c.allocatePorts(conf)
+	if err != nil {
+		return err
+	}
+	if portsChanged {
+		if conf, err = c.Config(); err != nil {
+			return &CannotBuildAppError{err}
+		}
+
+		if nodes, err = c.nodes(conf); err != nil {
+			return err
+		}
 	}
 
+	// applyFixtures determines if the fixtures must be applied once the chain is started
+	var applyFixtures bool
+
 	// init phase
 	// nolint:gocritic
-	if !isInit || (appModified && !exportGenesisExists) {
+	if fromSnapshot != "" {
+		// the chain must be initialized to have the node homes where the snapshot is restored
+		if !isInit {
+			fmt.Fprintln(c.stdLog().out, "💿 Initializing the app...")
+
+			if err := c.Init(ctx, true); err != nil {
+				return err
+			}
+		}
+
+		fmt.Fprintf(c.stdLog().out, "💿 Restoring the %q snapshot...\n", fromSnapshot)
+
+		start := c.phaseStarted(events.PhaseRestore, events.Message(fromSnapshot))
+		_, err := c.RestoreSnapshot(ctx, fromSnapshot)
+		c.phaseDone(events.PhaseRestore, start, err, events.Message(fromSnapshot))
+		if err != nil {
+			return err
+		}
+
+		if err := c.resetTxSession(); err != nil {
+			return err
+		}
+	} else if fromExport != "" {
+		fmt.Fprintf(c.stdLog().out, "💿 Forking the state of %s...\n", fromExport)
+
+		if err := c.Fork(ctx, fromExport, forkOptions...); err != nil {
+			return err
+		}
+
+		if err := c.resetTxSession(); err != nil {
+			return err
+		}
+
+		// the forked state is initialized with the current app
+		if err := c.markGenesisMigrationsApplied(cacheStorage); err != nil {
+			return err
+		}
+	} else if !isInit || (appModified && !exportGenesisExists) {
 		fmt.Fprintln(c.stdLog().out, "💿 Initializing the app...")
 
 		if err := c.Init(ctx, true); err != nil {
 			return err
 		}
+
+		if err := c.resetTxSession(); err != nil {
+			return err
+		}
+
+		// the genesis migrations only apply to the states initialized with a previous version of the app
+		if err := c.markGenesisMigrationsApplied(cacheStorage); err != nil {
+			return err
+		}
+
+		// the fixtures populate the state each time the chain is initialized
+		applyFixtures = conf.Fixtures != ""
 	} else if appModified {
 		// if the chain is already initialized but the source has been modified
 		// we reset the chain database and import the genesis state
 		fmt.Fprintln(c.stdLog().out, "💿 Existent genesis detected, restoring the database...")
 
-		if err := commands.UnsafeReset(ctx); err != nil {
-			return err
+		// migrate the exported state to make it compatible with the modified source
+		if err := c.migrateExportedGenesis(cacheStorage); err != nil {
+			return &CannotBuildAppError{err}
 		}
 
-		if err := c.importChainState(); err != nil {
+		if err := c.restoreChainState(ctx, nodes); err != nil {
 			return err
 		}
 	} else {
 		fmt.Fprintln(c.stdLog().out, "▶️  Restarting existing app...")
 	}
 
+	// update the node configs when the chain is not initialized with the new addresses
+	if portsChanged && isInit {
+		if err := c.configureNodes(ctx, conf, nodes); err != nil {
+			return err
+		}
+	}
+
 	// save checksums
-	if c.ConfigPath() != "" {
-		if err := dirchange.SaveDirChecksum(dirCache, configChecksumKey, c.app.Path, c.ConfigPath()); err != nil {
+	if configPaths := c.configPaths(); len(configPaths) > 0 {
+		if err := dirchange.SaveDirChecksum(dirCache, configChecksumKey, c.app.Path, configPaths...); err != nil {
 			return err
 		}
 	}
-	if err := dirchange.SaveDirChecksum(dirCache, sourceChecksumKey, c.app.Path, appBackendSourceWatchPaths...); err != nil {
+	if err := dirchange.SaveDirChecksum(dirCache, sourceChecksumKey, c.app.Path, appSourceChecksumPaths()...); err != nil {
 		return err
 	}
 	binaryPath, err = exec.LookPath(binaryName)
@@ -383,19 +573,85 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 	}
 
 	// start the blockchain
-	return c.start(ctx, conf)
+	return c.start(ctx, conf, applyFixtures)
 }
 
-func (c *Chain) start(ctx context.Context, config *chainconfig.Config) error {
-	commands, err := c.Commands(ctx)
+func (c *Chain) start(ctx context.Context, config *chainconfig.Config, applyFixtures bool) (err error) {
+	start := time.Now()
+	defer func() { c.phaseDone(events.PhaseStart, start, err) }()
+
+	nodes, err := c.nodes(config)
 	if err != nil {
 		return err
 	}
 
+	if err := c.printDevPreset(config, nodes); err != nil {
+		return err
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 
-	// start the blockchain.
-	g.Go(func() error { return c.plugin.Start(ctx, commands, config) })
+	// start a blockchain node for each validator.
+	for _, n := range nodes {
+		commands, err := c.nodeCommands(ctx, n)
+		if err != nil {
+			return err
+		}
+
+		commands, logs, err := c.nodeLogCommands(commands, n)
+		if err != nil {
+			return err
+		}
+
+		if logs.file != nil {
+			fmt.Fprintf(c.stdLog().out, "📜 Node logs are saved in %s\n", logs.file.Path())
+		}
+
+		validator, args := n.validator, c.nodeStartArgs(n)
+		g.Go(func() error {
+			defer logs.Close()
+			return c.plugin.Start(ctx, commands, validator, args...)
+		})
+	}
+
+	// fixturesApplied is closed once the fixtures are applied
+	var fixturesApplied chan struct{}
+	if applyFixtures {
+		fixturesApplied = make(chan struct{})
+	}
+
+	// record the delivered transactions to be able to replay them after a state reset.
+	// the recording is best effort and it doesn't stop the chain when it fails.
+	g.Go(func() error {
+		if err := c.waitNodesHealthy(ctx, nodes); err != nil {
+			return nil
+		}
+
+		if err := c.recordTxs(ctx, config, fixturesApplied); err != nil && !errors.Is(err, context.Canceled) {
+			fmt.Fprintf(c.stdLog().err, "⚠️  Transactions are not recorded: %s\n", err)
+		}
+
+		return nil
+	})
+
+	// apply the fixtures and run the post start hook once the RPC of the nodes is healthy
+	if applyFixtures || len(config.Hooks.PostStart) > 0 {
+		g.Go(func() error {
+			if err := c.waitNodesHealthy(ctx, nodes); err != nil {
+				return err
+			}
+
+			if applyFixtures {
+				if err := c.applyFixtures(ctx, config); err != nil {
+					return err
+				}
+
+				close(fixturesApplied)
+			}
+
+			return c.runHook(ctx, hookPostStart, config.Hooks.PostStart)
+		})
+	}
 
 	// start the faucet if enabled.
 	faucet, err := c.Faucet(ctx)
@@ -419,29 +675,46 @@ func (c *Chain) start(ctx context.Context, config *chainconfig.Config) error {
 
 	// set the app as being served
 	c.served = true
-
-	// Get the first validator
-	validator := config.Validators[0]
-	servers, err := validator.GetServers()
-	if err != nil {
-		return err
-	}
+	c.control.setRunning(true)
 
 	// note: address format errors are handled by the
 	// error group, so they can be safely ignored here
 
-	rpcAddr, _ := xurl.HTTP(servers.RPC.Address)
-	apiAddr, _ := xurl.HTTP(servers.API.Address)
-
 	// print the server addresses.
-	fmt.Fprintf(c.stdLog().out, "🌍 Tendermint node: %s\n", rpcAddr)
-	fmt.Fprintf(c.stdLog().out, "🌍 Blockchain API: %s\n", apiAddr)
+	addresses := make(map[string]string)
+	for _, n := range nodes {
+		servers, err := n.validator.GetServers()
+		if err != nil {
+			return err
+		}
+
+		rpcAddr, _ := xurl.HTTP(servers.RPC.Address)
+		apiAddr, _ := xurl.HTTP(servers.API.Address)
+
+		if len(nodes) == 1 {
+			fmt.Fprintf(c.stdLog().out, "🌍 Tendermint node: %s\n", rpcAddr)
+			fmt.Fprintf(c.stdLog().out, "🌍 Blockchain API: %s\n", apiAddr)
+
+			addresses["rpc"] = rpcAddr
+			addresses["api"] = apiAddr
+		} else {
+			fmt.Fprintf(c.stdLog().out, "🌍 Tendermint node (%s): %s\n", n.name, rpcAddr)
+			fmt.Fprintf(c.stdLog().out, "🌍 Blockchain API (%s): %s\n", n.name, apiAddr)
+
+			addresses[n.name+".rpc"] = rpcAddr
+			addresses[n.name+".api"] = apiAddr
+		}
+	}
 
 	if isFaucetEnabled {
 		faucetAddr, _ := xurl.HTTP(chainconfig.FaucetHost(config))
 		fmt.Fprintf(c.stdLog().out, "🌍 Token faucet: %s\n", faucetAddr)
+
+		addresses["faucet"] = faucetAddr
 	}
 
+	c.phaseStarted(events.PhaseStart, events.Addresses(addresses))
+
 	return g.Wait()
 }
 
@@ -459,6 +732,15 @@ func (c *Chain) runFaucetServer(ctx context.Context, faucet cosmosfaucet.Faucet)
 
 // saveChainState runs the export command of the chain and store the exported genesis in the chain saved config
 func (c *Chain) saveChainState(ctx context.Context, commands chaincmdrunner.Runner) error {
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	if err := c.runHook(ctx, hookPreExport, conf.Hooks.PreExport); err != nil {
+		return err
+	}
+
 	genesisPath, err := c.exportedGenesisPath()
 	if err != nil {
 		return err
@@ -467,18 +749,37 @@ func (c *Chain) saveChainState(ctx context.Context, commands chaincmdrunner.Runn
 	return commands.Export(ctx, genesisPath)
 }
 
-// importChainState imports the saved genesis in chain config to use it as the genesis
-func (c *Chain) importChainState() error {
-	exportGenesisPath, err := c.exportedGenesisPath()
-	if err != nil {
-		return err
+// restoreChainState resets the database of the nodes and imports the saved genesis
+func (c *Chain) restoreChainState(ctx context.Context, nodes []node) (err error) {
+	start := c.phaseStarted(events.PhaseRestore)
+	defer func() { c.phaseDone(events.PhaseRestore, start, err) }()
+
+	for _, n := range nodes {
+		commands, err := c.nodeCommands(ctx, n)
+		if err != nil {
+			return err
+		}
+
+		if err := commands.UnsafeReset(ctx); err != nil {
+			return err
+		}
+
+		if err := c.importChainState(n); err != nil {
+			return err
+		}
 	}
-	genesisPath, err := c.GenesisPath()
+
+	return nil
+}
+
+// importChainState imports the saved genesis in chain config to use it as the genesis of a node
+func (c *Chain) importChainState(n node) error {
+	exportGenesisPath, err := c.exportedGenesisPath()
 	if err != nil {
 		return err
 	}
 
-	return copy.Copy(exportGenesisPath, genesisPath)
+	return copy.Copy(exportGenesisPath, filepath.Join(n.home, "config/genesis.json"))
 }
 
 // chainSavePath returns the path where the chain state is saved
@@ -525,24 +826,57 @@ func (e *CannotBuildAppError) Unwrap() error {
 	return e.Err
 }
 
+// MarshalJSON serializes the error with its fields.
+func (e *CannotBuildAppError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Err string `json:"err"`
+	}{
+		Err: errorString(e.Err),
+	})
+}
+
 type CannotStartAppError struct {
 	AppName string
 	Err     error
 }
 
 func (e *CannotStartAppError) Error() string {
-	return fmt.Sprintf("cannot run %sd start:\n%s", e.AppName, errors.Unwrap(e.Err))
+	return fmt.Sprintf("cannot run %sd start:\n%s", e.AppName, e.startErr())
+}
+
+// startErr returns the error of the app start command, which is wrapped
+// into the error when the command exits with its error logs.
+func (e *CannotStartAppError) startErr() error {
+	if err := errors.Unwrap(e.Err); err != nil {
+		return err
+	}
+	return e.Err
 }
 
 func (e *CannotStartAppError) Unwrap() error {
 	return e.Err
 }
 
+// MarshalJSON serializes the error with its fields.
+func (e *CannotStartAppError) MarshalJSON() ([]byte, error) {
+	parsedErr := e.ParseStartError()
+
+	return json.Marshal(struct {
+		AppName   string `json:"app_name"`
+		Err       string `json:"err"`
+		ParsedErr string `json:"parsed_err,omitempty"`
+	}{
+		AppName:   e.AppName,
+		Err:       errorString(e.Err),
+		ParsedErr: parsedErr,
+	})
+}
+
 // ParseStartError parses the error into a clear error string
 // The error logs from Cosmos SDK application are too extensive to be directly printed
 // If the error is not recognized, returns an empty string
 func (e *CannotStartAppError) ParseStartError() string {
-	errorLogs := errors.Unwrap(e.Err).Error()
+	errorLogs := errorString(e.startErr())
 	switch {
 	case strings.Contains(errorLogs, "bind: address already in use"):
 		r := regexp.MustCompile(`listen .* bind: address already in use`)
@@ -553,3 +887,10 @@ func (e *CannotStartAppError) ParseStartError() string {
 		return ""
 	}
 }
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,37 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCannotStartAppError(t *testing.T) {
+	cases := []struct {
+		name, wantErr, wantParsed string
+		err                       error
+	}{
+		{
+			name:       "start error logs",
+			err:        fmt.Errorf("exit status 1: %w", errors.New("listen tcp 0.0.0.0:26657: bind: address already in use")),
+			wantErr:    "cannot run marsd start:\nlisten tcp 0.0.0.0:26657: bind: address already in use",
+			wantParsed: "listen tcp 0.0.0.0:26657: bind: address already in use",
+		},
+		{
+			name:    "error without wrapped error",
+			err:     errors.New("expected 2 encoded fixture messages, got 1"),
+			wantErr: "cannot run marsd start:\nexpected 2 encoded fixture messages, got 1",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &CannotStartAppError{"mars", tt.err}
+
+			require.Equal(t, tt.wantErr, err.Error())
+			require.Equal(t, tt.wantParsed, err.ParseStartError())
+		})
+	}
+}
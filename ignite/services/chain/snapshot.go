@@ -0,0 +1,491 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/otiai10/copy"
+	"github.com/pkg/errors"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/dirchange"
+	"github.com/ignite/cli/ignite/pkg/jsonfile"
+	"github.com/ignite/cli/ignite/pkg/xfilepath"
+)
+
+const (
+	// snapshotsDir is the name of the directory where chain snapshots are saved
+	snapshotsDir = "snapshots"
+
+	// snapshotFile is the name of the file that contains the snapshot info
+	snapshotFile = "snapshot.json"
+
+	// snapshotGenesis is the name of the snapshot's exported genesis file
+	snapshotGenesis = "genesis.json"
+
+	// snapshotNodesDir is the name of the directory where node files are saved
+	snapshotNodesDir = "nodes"
+
+	// fieldInitialHeight is the genesis field with the initial block height
+	fieldInitialHeight = "initial_height"
+)
+
+var (
+	// snapshotsSavePath is the place where the chain snapshots are saved
+	snapshotsSavePath = xfilepath.Join(
+		chainconfig.ConfigDirPath,
+		xfilepath.Path(snapshotsDir),
+	)
+
+	// ErrSnapshotNotFound is returned when a snapshot doesn't exist.
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+
+	// ErrSnapshotExists is returned when a snapshot with the same name already exists.
+	ErrSnapshotExists = errors.New("snapshot already exists")
+
+	// ErrSnapshotChainID is returned when a snapshot was saved from a chain with a different ID.
+	ErrSnapshotChainID = errors.New("snapshot chain ID doesn't match the chain ID")
+
+	// ErrInvalidSnapshotName is returned when a snapshot name contains invalid characters.
+	ErrInvalidSnapshotName = errors.New("snapshot name can only contain letters, digits, '.', '-' and '_'")
+
+	snapshotNameRe = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+	// snapshotNodeFiles are the node files required to restore the state with the same validators
+	snapshotNodeFiles = []string{
+		"config/node_key.json",
+		"config/priv_validator_key.json",
+	}
+)
+
+// Snapshot holds info about a saved state of the chain.
+type Snapshot struct {
+	// Name is the label of the snapshot.
+	Name string `json:"name"`
+
+	// ChainID is the ID of the chain when the snapshot was saved.
+	ChainID string `json:"chain_id"`
+
+	// Height is the last block height of the saved state.
+	Height int64 `json:"height"`
+
+	// SourceChecksum is the checksum of the chain's source code when the snapshot was saved.
+	SourceChecksum string `json:"source_checksum"`
+
+	// HasData indicates that the raw data directory of the nodes is included.
+	HasData bool `json:"has_data"`
+
+	// CreatedAt is the time when the snapshot was saved.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type snapshotOptions struct {
+	withData bool
+}
+
+// SnapshotOption configures snapshot saving.
+type SnapshotOption func(*snapshotOptions)
+
+// SnapshotWithData includes the data directory of the nodes in the snapshot.
+func SnapshotWithData() SnapshotOption {
+	return func(o *snapshotOptions) {
+		o.withData = true
+	}
+}
+
+type restoreOptions struct {
+	force bool
+}
+
+// RestoreOption configures snapshot restoring.
+type RestoreOption func(*restoreOptions)
+
+// RestoreForce restores a snapshot even when it was saved from a chain with a different ID.
+func RestoreForce() RestoreOption {
+	return func(o *restoreOptions) {
+		o.force = true
+	}
+}
+
+// SaveSnapshot saves the current state of the chain with a name.
+// The chain must be initialized and its nodes must not be running.
+func (c *Chain) SaveSnapshot(ctx context.Context, name string, options ...SnapshotOption) (Snapshot, error) {
+	var o snapshotOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return Snapshot{}, errors.Wrap(ErrSnapshotExists, name)
+	} else if !os.IsNotExist(err) {
+		return Snapshot{}, err
+	}
+
+	isInit, err := c.IsInitialized()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if !isInit {
+		return Snapshot{}, errors.New("the chain must be initialized to save a snapshot")
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	nodes, err := c.nodes(conf)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// the snapshot is saved in a temporary directory first to avoid
+	// leaving incomplete snapshots when there is an error
+	tmpPath := path + ".tmp"
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return Snapshot{}, err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	genesisPath := filepath.Join(tmpPath, snapshotGenesis)
+	if err := commands.Export(ctx, genesisPath); err != nil {
+		return Snapshot{}, err
+	}
+
+	height, err := exportedGenesisHeight(genesisPath)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	sourceChecksum, err := dirchange.ChecksumFromPaths(c.app.Path, appBackendSourceWatchPaths...)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	for _, n := range nodes {
+		if err := saveSnapshotNode(tmpPath, n, o.withData); err != nil {
+			return Snapshot{}, err
+		}
+	}
+
+	s := Snapshot{
+		Name:           name,
+		ChainID:        chainID,
+		Height:         height,
+		SourceChecksum: fmt.Sprintf("%x", sourceChecksum),
+		HasData:        o.withData,
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	if err := writeSnapshot(tmpPath, s); err != nil {
+		return Snapshot{}, err
+	}
+
+	return s, os.Rename(tmpPath, path)
+}
+
+// Snapshot returns a saved snapshot by name.
+func (c *Chain) Snapshot(name string) (Snapshot, error) {
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return readSnapshot(path)
+}
+
+// Snapshots returns all the saved snapshots of the chain sorted by creation time.
+func (c *Chain) Snapshots() ([]Snapshot, error) {
+	path, err := c.snapshotsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var snapshots []Snapshot
+	for _, e := range entries {
+		if !e.IsDir() || !snapshotNameRe.MatchString(e.Name()) {
+			continue
+		}
+
+		s, err := readSnapshot(filepath.Join(path, e.Name()))
+		if errors.Is(err, ErrSnapshotNotFound) {
+			// ignore directories that are not snapshots
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, s)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.Before(snapshots[j].CreatedAt)
+	})
+
+	return snapshots, nil
+}
+
+// RestoreSnapshot restores the state of the chain saved in a snapshot.
+// The chain must be initialized and its nodes must not be running.
+func (c *Chain) RestoreSnapshot(ctx context.Context, name string, options ...RestoreOption) (Snapshot, error) {
+	var o restoreOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	s, err := readSnapshot(path)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// the state of a snapshot saved from another chain can't be restored unless forced
+	if !o.force {
+		if err := checkSnapshotChainID(s, chainID); err != nil {
+			return Snapshot{}, err
+		}
+	}
+
+	isInit, err := c.IsInitialized()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if !isInit {
+		return Snapshot{}, errors.New("the chain must be initialized to restore a snapshot")
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	nodes, err := c.nodes(conf)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// make sure the snapshot contains the files for all the nodes before changing any of them
+	for _, n := range nodes {
+		if _, err := os.Stat(filepath.Join(path, snapshotNodesDir, n.name)); os.IsNotExist(err) {
+			return Snapshot{}, fmt.Errorf("snapshot %q doesn't contain the state of validator %q", name, n.name)
+		} else if err != nil {
+			return Snapshot{}, err
+		}
+	}
+
+	for _, n := range nodes {
+		nodePath := filepath.Join(path, snapshotNodesDir, n.name)
+
+		// restore the node keys and keyrings to keep the same validator set and accounts
+		if err := copy.Copy(nodePath, n.home, copy.Options{
+			Skip: func(src string) (bool, error) {
+				return src == filepath.Join(nodePath, "data"), nil
+			},
+		}); err != nil {
+			return Snapshot{}, err
+		}
+
+		// when the snapshot contains the data directory the nodes can continue
+		// from the saved height, otherwise the exported genesis is imported
+		if s.HasData {
+			dataPath := filepath.Join(n.home, "data")
+			if err := os.RemoveAll(dataPath); err != nil {
+				return Snapshot{}, err
+			}
+
+			if err := copy.Copy(filepath.Join(nodePath, "data"), dataPath); err != nil {
+				return Snapshot{}, err
+			}
+
+			continue
+		}
+
+		commands, err := c.nodeCommands(ctx, n)
+		if err != nil {
+			return Snapshot{}, err
+		}
+
+		if err := commands.UnsafeReset(ctx); err != nil {
+			return Snapshot{}, err
+		}
+
+		if err := copy.Copy(filepath.Join(path, snapshotGenesis), filepath.Join(n.home, "config/genesis.json")); err != nil {
+			return Snapshot{}, err
+		}
+	}
+
+	return s, nil
+}
+
+// checkSnapshotChainID checks that a snapshot was saved from a chain with the same ID.
+func checkSnapshotChainID(s Snapshot, chainID string) error {
+	if s.ChainID != chainID {
+		return errors.Wrapf(ErrSnapshotChainID, "snapshot %q was saved from chain %q, the chain ID is %q", s.Name, s.ChainID, chainID)
+	}
+
+	return nil
+}
+
+// DeleteSnapshot deletes a saved snapshot.
+func (c *Chain) DeleteSnapshot(name string) error {
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := readSnapshot(path); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(path)
+}
+
+// snapshotsPath returns the path of the directory where the chain snapshots are saved.
+// The snapshots are saved by app instead of by chain ID so the snapshots saved before
+// the chain ID of the app changed can still be found.
+func (c *Chain) snapshotsPath() (string, error) {
+	savePath, err := snapshotsSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, c.app.N()), nil
+}
+
+// snapshotPath returns the path of the directory of a snapshot.
+func (c *Chain) snapshotPath(name string) (string, error) {
+	if !snapshotNameRe.MatchString(name) {
+		return "", errors.Wrap(ErrInvalidSnapshotName, name)
+	}
+
+	path, err := c.snapshotsPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(path, name), nil
+}
+
+// saveSnapshotNode saves the files of a node required to restore the chain state.
+func saveSnapshotNode(snapshotPath string, n node, withData bool) error {
+	nodePath := filepath.Join(snapshotPath, snapshotNodesDir, n.name)
+
+	for _, name := range snapshotNodeFiles {
+		if err := copy.Copy(filepath.Join(n.home, name), filepath.Join(nodePath, name)); err != nil {
+			return err
+		}
+	}
+
+	// keyrings are saved to keep the keys of the chain accounts
+	keyrings, err := filepath.Glob(filepath.Join(n.home, "keyring-*"))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range keyrings {
+		if err := copy.Copy(path, filepath.Join(nodePath, filepath.Base(path))); err != nil {
+			return err
+		}
+	}
+
+	if !withData {
+		return nil
+	}
+
+	// the data directory must be restored with the genesis that the node was started with
+	name := "config/genesis.json"
+	if err := copy.Copy(filepath.Join(n.home, name), filepath.Join(nodePath, name)); err != nil {
+		return err
+	}
+
+	return copy.Copy(filepath.Join(n.home, "data"), filepath.Join(nodePath, "data"))
+}
+
+func readSnapshot(path string) (Snapshot, error) {
+	bz, err := os.ReadFile(filepath.Join(path, snapshotFile))
+	if os.IsNotExist(err) {
+		return Snapshot{}, errors.Wrap(ErrSnapshotNotFound, filepath.Base(path))
+	} else if err != nil {
+		return Snapshot{}, err
+	}
+
+	var s Snapshot
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return Snapshot{}, err
+	}
+
+	return s, nil
+}
+
+func writeSnapshot(path string, s Snapshot) error {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(path, snapshotFile), bz, 0o644)
+}
+
+// exportedGenesisHeight returns the last block height of the state in an exported genesis.
+func exportedGenesisHeight(path string) (int64, error) {
+	genesis, err := jsonfile.FromPath(path)
+	if err != nil {
+		return 0, err
+	}
+	defer genesis.Close()
+
+	var initialHeight string
+	if err := genesis.Field(fieldInitialHeight, &initialHeight); err != nil {
+		return 0, err
+	}
+
+	height, err := strconv.ParseInt(initialHeight, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid genesis initial height %q: %w", initialHeight, err)
+	}
+
+	// an exported genesis starts at the block next to the last exported one
+	if height > 0 {
+		height--
+	}
+
+	return height, nil
+}
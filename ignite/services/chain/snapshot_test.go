@@ -0,0 +1,72 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := readSnapshot(dir)
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+
+	s := Snapshot{
+		Name:           "before-migration",
+		ChainID:        "mars",
+		Height:         42,
+		SourceChecksum: "abc",
+		HasData:        true,
+		CreatedAt:      time.Date(2022, 7, 1, 10, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, writeSnapshot(dir, s))
+
+	got, err := readSnapshot(dir)
+	require.NoError(t, err)
+	require.Equal(t, s, got)
+}
+
+func TestSnapshotPathInvalidName(t *testing.T) {
+	c := &Chain{}
+
+	for _, name := range []string{"", "../mars", "before migration", "a/b"} {
+		_, err := c.snapshotPath(name)
+		require.ErrorIs(t, err, ErrInvalidSnapshotName, name)
+	}
+}
+
+func TestCheckSnapshotChainID(t *testing.T) {
+	s := Snapshot{Name: "before-migration", ChainID: "mars"}
+
+	require.NoError(t, checkSnapshotChainID(s, "mars"))
+	require.ErrorIs(t, checkSnapshotChainID(s, "venus"), ErrSnapshotChainID)
+}
+
+func TestRestoreSnapshotChainID(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	saved := &Chain{app: App{Name: "mars"}, options: chainOptions{chainID: "mars"}}
+	path, err := saved.snapshotPath("before-migration")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(path, 0o755))
+	require.NoError(t, writeSnapshot(path, Snapshot{Name: "before-migration", ChainID: "mars"}))
+
+	// the snapshot is found after the chain ID of the app changed
+	c := &Chain{app: App{Name: "mars"}, options: chainOptions{chainID: "mars-2"}}
+	_, err = c.RestoreSnapshot(context.Background(), "before-migration")
+	require.ErrorIs(t, err, ErrSnapshotChainID)
+}
+
+func TestExportedGenesisHeight(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"chain_id":"mars","initial_height":"43"}`), 0o644))
+
+	height, err := exportedGenesisHeight(path)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, height)
+}
@@ -0,0 +1,336 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/pkg/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+const (
+	// txSessionFile is the name of the file where the transactions of the current state are recorded
+	txSessionFile = "txs.jsonl"
+
+	// lastTxSessionFile is the name of the file that keeps the transactions recorded before the last state reset
+	lastTxSessionFile = "txs.last.jsonl"
+)
+
+// pubKeyRegistry resolves the public keys of the transaction signers.
+var pubKeyRegistry = func() codectypes.InterfaceRegistry {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return registry
+}()
+
+// RecordedTx is a transaction delivered by the chain while it was served.
+type RecordedTx struct {
+	// Height is the height of the block that includes the transaction.
+	Height int64 `json:"height"`
+
+	// Hash is the hash of the transaction.
+	Hash string `json:"hash"`
+
+	// Signer is the address of the account that signed the transaction.
+	Signer string `json:"signer"`
+
+	// Messages are the messages of the transaction.
+	Messages []RecordedMsg `json:"messages"`
+}
+
+// RecordedMsg is a protobuf encoded transaction message.
+type RecordedMsg struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+// decodeRecordedTx decodes a transaction delivered by the chain.
+// Only the transactions signed by a single account can be decoded.
+// The signer is left empty when the type of its public key is unknown.
+func decodeRecordedTx(res *ctypes.ResultTx, addressPrefix string) (RecordedTx, error) {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(res.Tx); err != nil {
+		return RecordedTx{}, err
+	}
+
+	var body txtypes.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return RecordedTx{}, err
+	}
+
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(raw.AuthInfoBytes); err != nil {
+		return RecordedTx{}, err
+	}
+
+	if len(authInfo.SignerInfos) != 1 {
+		return RecordedTx{}, fmt.Errorf("expected a single signer, got %d", len(authInfo.SignerInfos))
+	}
+
+	if authInfo.SignerInfos[0].PublicKey == nil {
+		return RecordedTx{}, errors.New("the public key of the signer is missing")
+	}
+
+	tx := RecordedTx{
+		Height:   res.Height,
+		Hash:     res.Hash.String(),
+		Messages: make([]RecordedMsg, len(body.Messages)),
+	}
+
+	// chains can use other key types than the ones of the SDK, for example to sign
+	// with Ethereum keys, the transaction is recorded without a signer in that case
+	var pubKey cryptotypes.PubKey
+	if err := pubKeyRegistry.UnpackAny(authInfo.SignerInfos[0].PublicKey, &pubKey); err == nil {
+		signer, err := sdktypes.Bech32ifyAddressBytes(addressPrefix, pubKey.Address())
+		if err != nil {
+			return RecordedTx{}, err
+		}
+
+		tx.Signer = signer
+	}
+
+	for i, msg := range body.Messages {
+		tx.Messages[i] = RecordedMsg{
+			TypeURL: msg.TypeUrl,
+			Value:   msg.Value,
+		}
+	}
+
+	return tx, nil
+}
+
+// readRecordedTxs reads the transactions recorded in a tx session file.
+func readRecordedTxs(path string) ([]RecordedTx, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var txs []RecordedTx
+
+	dec := json.NewDecoder(file)
+	for {
+		var tx RecordedTx
+		if err := dec.Decode(&tx); err == io.EOF {
+			return txs, nil
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "invalid tx session file %s", path)
+		}
+
+		txs = append(txs, tx)
+	}
+}
+
+// appendRecordedTxs appends transactions to a tx session file, one JSON encoded transaction per line.
+func appendRecordedTxs(path string, txs []RecordedTx) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	enc := json.NewEncoder(file)
+	for _, tx := range txs {
+		if err := enc.Encode(tx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// txSessionPath returns the path of a tx session file inside the chain's save path.
+func (c *Chain) txSessionPath(name string) (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(savePath, 0o700); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, name), nil
+}
+
+// rotateTxSession keeps the transactions recorded for the current state so they can
+// be replayed once the state is reset. The transactions kept from a previous reset
+// are only replaced when there are new recorded transactions.
+// It returns the number of transactions that can be replayed.
+func (c *Chain) rotateTxSession() (int, error) {
+	current, err := c.txSessionPath(txSessionFile)
+	if err != nil {
+		return 0, err
+	}
+
+	txs, err := readRecordedTxs(current)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if len(txs) == 0 {
+		return 0, os.Remove(current)
+	}
+
+	last, err := c.txSessionPath(lastTxSessionFile)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(txs), os.Rename(current, last)
+}
+
+// resetTxSession starts a new tx session when the state of the chain is reset.
+func (c *Chain) resetTxSession() error {
+	count, err := c.rotateTxSession()
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		fmt.Fprintf(
+			c.stdLog().out,
+			"📼 %d transactions were recorded before the state reset, run %s to broadcast them again\n",
+			count,
+			infoColor("ignite chain replay"),
+		)
+	}
+
+	return nil
+}
+
+// recordTxs records the transactions delivered by the chain until the context is canceled.
+// The transactions are recorded in the tx session file, the failed transactions are skipped.
+// When fixturesApplied is not nil the recording starts once it's closed, after the fixtures are
+// applied. The fixtures and the faucet transactions are not recorded because they are broadcasted
+// again in the new state, replaying them would duplicate their changes.
+func (c *Chain) recordTxs(ctx context.Context, conf *chainconfig.Config, fixturesApplied <-chan struct{}) error {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	prefix, err := c.addressPrefix(ctx, commands, conf)
+	if err != nil {
+		return err
+	}
+
+	var faucetAddress string
+	if conf.Faucet.Name != nil {
+		acc, err := commands.ShowAccount(ctx, *conf.Faucet.Name)
+		if err != nil {
+			return err
+		}
+
+		faucetAddress = acc.Address
+	}
+
+	client, err := c.localClient(ctx, conf, prefix)
+	if err != nil {
+		return err
+	}
+
+	path, err := c.txSessionPath(txSessionFile)
+	if err != nil {
+		return err
+	}
+
+	// continue the recording after the last recorded transaction
+	recorded, err := readRecordedTxs(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	fromHeight := int64(1)
+	if len(recorded) > 0 {
+		fromHeight = recorded[len(recorded)-1].Height + 1
+	}
+
+	if fixturesApplied != nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-fixturesApplied:
+		}
+
+		// the fixture transactions are included in the blocks up to the latest one
+		latestHeight, err := client.LatestBlockHeight(ctx)
+		if err != nil {
+			return err
+		}
+
+		fromHeight = latestHeight + 1
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		latestHeight, err := client.LatestBlockHeight(ctx)
+		if err != nil {
+			continue
+		}
+
+		// the txs of the latest block might not be indexed yet
+		toHeight := latestHeight - 1
+		if toHeight < fromHeight {
+			continue
+		}
+
+		results, err := client.DeliveredTxs(ctx, fromHeight, toHeight)
+		if err != nil {
+			continue
+		}
+
+		var txs []RecordedTx
+		for _, res := range results {
+			if !res.TxResult.IsOK() {
+				continue
+			}
+
+			tx, err := decodeRecordedTx(res, prefix)
+			if err != nil {
+				fmt.Fprintf(c.stdLog().err, "⚠️  Transaction %s is not recorded: %s\n", res.Hash, err)
+				continue
+			}
+
+			if faucetAddress != "" && tx.Signer == faucetAddress {
+				continue
+			}
+
+			txs = append(txs, tx)
+		}
+
+		if err := appendRecordedTxs(path, txs); err != nil {
+			return err
+		}
+
+		fromHeight = toHeight + 1
+	}
+}
@@ -0,0 +1,124 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+func TestDecodeRecordedTx(t *testing.T) {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+
+	newTx := func(signerInfos ...*txtypes.SignerInfo) []byte {
+		body := txtypes.TxBody{
+			Messages: []*codectypes.Any{{TypeUrl: "/mars.MsgFoo", Value: []byte{1, 2, 3}}},
+		}
+		bodyBytes, err := body.Marshal()
+		require.NoError(t, err)
+
+		authInfo := txtypes.AuthInfo{SignerInfos: signerInfos}
+		authInfoBytes, err := authInfo.Marshal()
+		require.NoError(t, err)
+
+		raw := txtypes.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes}
+		bz, err := raw.Marshal()
+		require.NoError(t, err)
+
+		return bz
+	}
+
+	t.Run("single signer", func(t *testing.T) {
+		res := &ctypes.ResultTx{
+			Hash:     []byte{0xab, 0xcd},
+			Height:   3,
+			TxResult: abci.ResponseDeliverTx{},
+			Tx:       newTx(&txtypes.SignerInfo{PublicKey: pubKeyAny}),
+		}
+
+		tx, err := decodeRecordedTx(res, "mars")
+		require.NoError(t, err)
+
+		signer, err := sdktypes.Bech32ifyAddressBytes("mars", pubKey.Address())
+		require.NoError(t, err)
+
+		require.Equal(t, RecordedTx{
+			Height:   3,
+			Hash:     "ABCD",
+			Signer:   signer,
+			Messages: []RecordedMsg{{TypeURL: "/mars.MsgFoo", Value: []byte{1, 2, 3}}},
+		}, tx)
+	})
+
+	t.Run("unknown public key type", func(t *testing.T) {
+		res := &ctypes.ResultTx{
+			Hash:   []byte{0xab, 0xcd},
+			Height: 3,
+			Tx: newTx(&txtypes.SignerInfo{
+				PublicKey: &codectypes.Any{TypeUrl: "/ethermint.crypto.v1.ethsecp256k1.PubKey", Value: []byte{1}},
+			}),
+		}
+
+		tx, err := decodeRecordedTx(res, "mars")
+		require.NoError(t, err)
+		require.Empty(t, tx.Signer)
+		require.Equal(t, []RecordedMsg{{TypeURL: "/mars.MsgFoo", Value: []byte{1, 2, 3}}}, tx.Messages)
+	})
+
+	t.Run("multiple signers", func(t *testing.T) {
+		res := &ctypes.ResultTx{
+			Tx: newTx(&txtypes.SignerInfo{PublicKey: pubKeyAny}, &txtypes.SignerInfo{PublicKey: pubKeyAny}),
+		}
+
+		_, err := decodeRecordedTx(res, "mars")
+		require.EqualError(t, err, "expected a single signer, got 2")
+	})
+
+	t.Run("missing public key", func(t *testing.T) {
+		res := &ctypes.ResultTx{
+			Tx: newTx(&txtypes.SignerInfo{}),
+		}
+
+		_, err := decodeRecordedTx(res, "mars")
+		require.EqualError(t, err, "the public key of the signer is missing")
+	})
+}
+
+func TestRecordedTxs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), txSessionFile)
+
+	_, err := readRecordedTxs(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	txs := []RecordedTx{
+		{
+			Height:   2,
+			Hash:     "AB",
+			Signer:   "mars1a",
+			Messages: []RecordedMsg{{TypeURL: "/mars.MsgFoo", Value: []byte{1}}},
+		},
+		{
+			Height:   5,
+			Hash:     "CD",
+			Signer:   "mars1b",
+			Messages: []RecordedMsg{{TypeURL: "/mars.MsgBar", Value: []byte{2}}},
+		},
+	}
+
+	require.NoError(t, appendRecordedTxs(path, txs[:1]))
+	require.NoError(t, appendRecordedTxs(path, nil))
+	require.NoError(t, appendRecordedTxs(path, txs[1:]))
+
+	recorded, err := readRecordedTxs(path)
+	require.NoError(t, err)
+	require.Equal(t, txs, recorded)
+}
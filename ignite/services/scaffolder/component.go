@@ -21,6 +21,7 @@ const (
 	componentMessage = "message"
 	componentQuery   = "query"
 	componentPacket  = "packet"
+	componentEvent   = "event"
 
 	protoFolder = "proto"
 )
@@ -130,6 +131,7 @@ func checkComponentCreated(appPath, moduleName string, compName multiformatname.
 		"Query" + compName.UpperCamel + "Request":     componentQuery,
 		"Query" + compName.UpperCamel + "Response":    componentQuery,
 		compName.UpperCamel + "PacketData":            componentPacket,
+		"Event" + compName.UpperCamel:                 componentEvent,
 	}
 
 	if !noMessage {
@@ -216,8 +218,8 @@ func checkCustomTypes(ctx context.Context, path, appName, module string, fields
 			continue
 		}
 		fieldType := datatype.Name(fieldSplit[1])
-		if _, ok := datatype.SupportedTypes[fieldType]; !ok {
-			customFields = append(customFields, string(fieldType))
+		if _, ok := datatype.SupportedTypes[fieldType]; !ok && fieldType != datatype.Enum {
+			customFields = append(customFields, datatype.CustomType(fieldType))
 		}
 	}
 	return protoanalysis.HasMessages(ctx, protoPath, customFields...)
@@ -231,7 +233,7 @@ func containCustomTypes(fields []string) bool {
 			continue
 		}
 		fieldType := datatype.Name(fieldSplit[1])
-		if _, ok := datatype.SupportedTypes[fieldType]; !ok {
+		if _, ok := datatype.SupportedTypes[fieldType]; !ok && fieldType != datatype.Enum {
 			return true
 		}
 	}
@@ -0,0 +1,160 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/event"
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+// AddEvent adds a new typed event to scaffolded app
+func (s Scaffolder) AddEvent(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	eventName string,
+	fields,
+	emitFrom []string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the type to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(eventName)
+	if err != nil {
+		return sm, err
+	}
+
+	if err := checkComponentValidity(s.path, moduleName, name, true); err != nil {
+		return sm, err
+	}
+
+	// Check and parse provided fields
+	if err := checkCustomTypes(ctx, s.path, s.modpath.Package, moduleName, fields); err != nil {
+		return sm, err
+	}
+	parsedFields, err := field.ParseFields(fields, checkGoReservedWord)
+	if err != nil {
+		return sm, err
+	}
+	if parsedFields.HasRules() {
+		return sm, errors.New("event fields can't have validation rules")
+	}
+
+	// Find the message handlers emitting the event
+	emitFromHandlers, err := findMsgHandlers(s.path, moduleName, emitFrom)
+	if err != nil {
+		return sm, err
+	}
+
+	var (
+		g    *genny.Generator
+		opts = &event.Options{
+			AppName:    s.modpath.Package,
+			AppPath:    s.path,
+			ModulePath: s.modpath.RawPath,
+			ModuleName: moduleName,
+			EventName:  name,
+			Fields:     parsedFields,
+			EmitFrom:   emitFromHandlers,
+		}
+	)
+
+	gens, err := supportEnums(
+		ctx,
+		nil,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+	)
+	if err != nil {
+		return sm, err
+	}
+
+	// Scaffold
+	g, err = event.NewStargate(opts)
+	if err != nil {
+		return sm, err
+	}
+	gens = append(gens, g)
+	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(cacheStorage, opts.AppPath, s.modpath.RawPath)
+}
+
+// findMsgHandlers returns the files of the keeper of a module where the handlers of the messages are defined
+func findMsgHandlers(appPath, moduleName string, msgNames []string) ([]event.EmitFrom, error) {
+	if len(msgNames) == 0 {
+		return nil, nil
+	}
+
+	keeperPath := filepath.Join(appPath, "x", moduleName, "keeper")
+	entries, err := os.ReadDir(keeperPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the non test Go files of the keeper once
+	files := make(map[string]string)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".go" || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+		path := filepath.Join(keeperPath, entry.Name())
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		files[path] = string(content)
+	}
+
+	var (
+		handlers []event.EmitFrom
+		exist    = make(map[string]struct{})
+	)
+	for _, msgName := range msgNames {
+		name, err := multiformatname.NewName(msgName)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := exist[name.UpperCamel]; ok {
+			return nil, fmt.Errorf("the message %s is duplicated", msgName)
+		}
+		exist[name.UpperCamel] = struct{}{}
+
+		handler := event.EmitFrom{MsgName: name}
+		for path, content := range files {
+			if strings.Contains(content, event.MsgServerFunc(name.UpperCamel)) {
+				handler.Path = path
+				break
+			}
+		}
+		if handler.Path == "" {
+			return nil, fmt.Errorf("the handler of the message %s doesn't exist in the module %s", msgName, moduleName)
+		}
+		handlers = append(handlers, handler)
+	}
+	return handlers, nil
+}
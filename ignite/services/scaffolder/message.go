@@ -2,6 +2,7 @@ package scaffolder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/gobuffalo/genny"
@@ -108,6 +109,9 @@ func (s Scaffolder) AddMessage(
 	if err != nil {
 		return sm, err
 	}
+	if parsedResFields.HasRules() {
+		return sm, errors.New("response fields can't have validation rules")
+	}
 
 	mfSigner, err := multiformatname.NewName(scaffoldingOpts.signer)
 	if err != nil {
@@ -157,6 +161,20 @@ func (s Scaffolder) AddMessage(
 		return sm, err
 	}
 
+	gens, err = supportEnums(
+		ctx,
+		gens,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+		opts.ResFields,
+	)
+	if err != nil {
+		return sm, err
+	}
+
 	// Scaffold
 	g, err = message.NewStargate(tracer, opts)
 	if err != nil {
@@ -182,6 +182,12 @@ func (s Scaffolder) CreateModule(
 	if err != nil {
 		return sm, err
 	}
+	if len(params.Enums()) > 0 {
+		return sm, errors.New("params can't contain enum type")
+	}
+	if params.HasRules() {
+		return sm, errors.New("params can't have validation rules")
+	}
 
 	// Check dependencies
 	if err := checkDependencies(creationOpts.dependencies, s.path); err != nil {
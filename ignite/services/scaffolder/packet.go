@@ -2,6 +2,7 @@ package scaffolder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -119,6 +120,9 @@ func (s Scaffolder) AddPacket(
 	if err != nil {
 		return sm, err
 	}
+	if parsedAcksFields.HasRules() {
+		return sm, errors.New("acknowledgment fields can't have validation rules")
+	}
 
 	// Generate the packet
 	var (
@@ -135,11 +139,26 @@ func (s Scaffolder) AddPacket(
 			MsgSigner:  mfSigner,
 		}
 	)
+	gens, err := supportEnums(
+		ctx,
+		nil,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+		opts.AckFields,
+	)
+	if err != nil {
+		return sm, err
+	}
+
 	g, err = ibc.NewPacket(tracer, opts)
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	gens = append(gens, g)
+	sm, err = xgenny.RunWithValidation(tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
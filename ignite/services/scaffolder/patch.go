@@ -1,12 +1,19 @@
 package scaffolder
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/gobuffalo/genny"
 
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/ignite/templates/enum"
+	"github.com/ignite/cli/ignite/templates/field"
 	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
 )
 
@@ -95,3 +102,68 @@ func isMsgServerDefined(appPath, appName, moduleName string) (bool, error) {
 	}
 	return true, err
 }
+
+// supportEnums checks if the enums of the fields exist in the module
+// appends the generators to create them if they don't.
+// An existing enum is reused when it has the same values
+func supportEnums(
+	ctx context.Context,
+	gens []*genny.Generator,
+	appPath,
+	appName,
+	modulePath,
+	moduleName string,
+	fields ...field.Fields,
+) ([]*genny.Generator, error) {
+	pkgs, err := protoanalysis.Parse(ctx, protoanalysis.NewCache(), filepath.Join(appPath, protoFolder, appName, moduleName))
+	if err != nil {
+		return nil, err
+	}
+
+	enumValues := make(map[string][]string)
+	for _, pkg := range pkgs {
+		for _, e := range pkg.Enums {
+			enumValues[e.Name] = e.Values
+		}
+	}
+
+	for _, f := range fields {
+		for _, enumField := range f.Enums() {
+			enumName, err := multiformatname.NewName(enumField.Datatype)
+			if err != nil {
+				return nil, err
+			}
+
+			values := make([]string, len(enumField.EnumValues))
+			for i, value := range enumField.EnumValues {
+				values[i] = enum.ProtoValueName(enumName, value)
+			}
+
+			if existingValues, ok := enumValues[enumName.UpperCamel]; ok {
+				if strings.Join(existingValues, ",") != strings.Join(values, ",") {
+					return nil, fmt.Errorf(
+						"the enum %s already exists in the module with the values %s",
+						enumName.UpperCamel,
+						strings.Join(existingValues, ", "),
+					)
+				}
+				continue
+			}
+			enumValues[enumName.UpperCamel] = values
+
+			g, err := enum.NewStargate(&enum.Options{
+				AppName:    appName,
+				AppPath:    appPath,
+				ModuleName: moduleName,
+				ModulePath: modulePath,
+				EnumName:   enumName,
+				Values:     enumField.EnumValues,
+			})
+			if err != nil {
+				return nil, err
+			}
+			gens = append(gens, g)
+		}
+	}
+	return gens, nil
+}
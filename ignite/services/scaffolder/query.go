@@ -53,6 +53,9 @@ func (s Scaffolder) AddQuery(
 	if err != nil {
 		return sm, err
 	}
+	if parsedReqFields.HasRules() {
+		return sm, errors.New("query request params can't have validation rules")
+	}
 
 	// Check and parse provided response fields
 	if err := checkCustomTypes(ctx, s.path, s.modpath.Package, moduleName, resFields); err != nil {
@@ -62,6 +65,9 @@ func (s Scaffolder) AddQuery(
 	if err != nil {
 		return sm, err
 	}
+	if parsedResFields.HasRules() {
+		return sm, errors.New("response fields can't have validation rules")
+	}
 
 	var (
 		g    *genny.Generator
@@ -78,12 +84,27 @@ func (s Scaffolder) AddQuery(
 		}
 	)
 
+	gens, err := supportEnums(
+		ctx,
+		nil,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.ReqFields,
+		opts.ResFields,
+	)
+	if err != nil {
+		return sm, err
+	}
+
 	// Scaffold
 	g, err = query.NewStargate(tracer, opts)
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	gens = append(gens, g)
+	sm, err = xgenny.RunWithValidation(tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
@@ -110,6 +110,7 @@ func protoc(cacheStorage cache.Storage, projectPath, gomodPath string) error {
 				cosmosgen.TypescriptModulePath(tsClientRootPath),
 				tsClientRootPath,
 			),
+			cosmosgen.WithDenomMetadata(chainconfig.ConfigDenomMetadata(conf)),
 		)
 	}
 
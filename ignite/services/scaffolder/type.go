@@ -2,6 +2,7 @@ package scaffolder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -159,6 +160,9 @@ func (s Scaffolder) AddType(
 	if err != nil {
 		return sm, err
 	}
+	if o.withoutMessage && tFields.HasRules() {
+		return sm, errors.New("validation rules can't be used without messages")
+	}
 
 	mfSigner, err := multiformatname.NewName(o.signer)
 	if err != nil {
@@ -238,6 +242,20 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
+	gens, err = supportEnums(
+		ctx,
+		gens,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+		opts.Indexes,
+	)
+	if err != nil {
+		return sm, err
+	}
+
 	// run the generation
 	gens = append(gens, g)
 	sm, err = xgenny.RunWithValidation(tracer, gens...)
@@ -287,6 +305,9 @@ func mapGenerator(replacer placeholder.Replacer, opts *typed.Options, indexes []
 	if err != nil {
 		return nil, err
 	}
+	if parsedIndexes.HasRules() {
+		return nil, errors.New("indexes can't have validation rules")
+	}
 
 	// Indexes and type fields must be disjoint
 	exists := make(map[string]struct{})
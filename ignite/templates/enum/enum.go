@@ -0,0 +1,60 @@
+package enum
+
+import (
+	"embed"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+//go:embed stargate/* stargate/**/*
+var fsStargate embed.FS
+
+// NewStargate returns the generator to scaffold the proto enum of an enum field
+// and its Go parsing and validation helpers in a Stargate module.
+func NewStargate(opts *Options) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
+	)
+
+	valueNames := make([]string, len(opts.Values))
+	for i, value := range opts.Values {
+		valueNames[i] = value.Original
+	}
+
+	appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("EnumName", opts.EnumName)
+	ctx.Set("Values", opts.Values)
+	ctx.Set("ValueNames", strings.Join(valueNames, ", "))
+	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName))
+	ctx.Set("protoValueName", ProtoValueName)
+	ctx.Set("toLower", strings.ToLower)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{enumName}}", opts.EnumName.Snake))
+
+	return g, xgenny.Box(g, template)
+}
+
+// ProtoValueName returns the name of an enum value in proto, the name is prefixed
+// with the enum name and upper cased, e.g. STATUS_PENDING.
+func ProtoValueName(enumName, value multiformatname.Name) string {
+	return strings.ToUpper(enumName.Snake + "_" + value.Snake)
+}
@@ -0,0 +1,15 @@
+package enum
+
+import (
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+// Options are options to scaffold an enum in a module
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	EnumName   multiformatname.Name
+	Values     []multiformatname.Name
+}
@@ -0,0 +1,97 @@
+package event
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+	"github.com/ignite/cli/ignite/templates/testutil"
+)
+
+//go:embed stargate/* stargate/**/*
+var fsStargate embed.FS
+
+// NewStargate returns the generator to scaffold a typed event and its emit helper
+// in a Stargate module. The event is emitted from the provided message handlers.
+func NewStargate(opts *Options) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsStargate, "stargate/", opts.AppPath)
+	)
+
+	for _, emitFrom := range opts.EmitFrom {
+		g.RunFn(msgServerModify(opts, emitFrom))
+	}
+
+	appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("EventName", opts.EventName)
+	ctx.Set("Fields", opts.Fields)
+	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName))
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{eventName}}", opts.EventName.Snake))
+
+	if err := xgenny.Box(g, template); err != nil {
+		return nil, err
+	}
+
+	// Create the 'testutil' package with the test helpers
+	return g, testutil.Register(g, opts.AppPath)
+}
+
+// MsgServerFunc returns the signature prefix of the handler of a message in the msg server of a module
+func MsgServerFunc(msgName string) string {
+	return fmt.Sprintf("func (k msgServer) %s(", msgName)
+}
+
+// msgServerModify emits the event before the response of a message handler is returned
+func msgServerModify(opts *Options, emitFrom EmitFrom) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(emitFrom.Path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		funcIndex := strings.Index(content, MsgServerFunc(emitFrom.MsgName.UpperCamel))
+		if funcIndex == -1 {
+			return fmt.Errorf("the handler of the message %s is not defined in %s", emitFrom.MsgName.UpperCamel, emitFrom.Path)
+		}
+
+		response := fmt.Sprintf("return &types.Msg%sResponse{", emitFrom.MsgName.UpperCamel)
+		responseIndex := strings.Index(content[funcIndex:], response)
+		if responseIndex == -1 {
+			return fmt.Errorf("the handler of the message %s doesn't return a response", emitFrom.MsgName.UpperCamel)
+		}
+
+		// The emit is inserted at the start of the line of the response
+		lineIndex := strings.LastIndex(content[:funcIndex+responseIndex], "\n") + 1
+		template := `	// TODO: Set the fields of the emitted event
+	if err := k.Emit%[1]vEvent(ctx, types.Event%[1]v{}); err != nil {
+		return nil, err
+	}
+
+`
+		replacement := fmt.Sprintf(template, opts.EventName.UpperCamel)
+		content = content[:lineIndex] + replacement + content[lineIndex:]
+
+		newFile := genny.NewFileS(emitFrom.Path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,26 @@
+package event
+
+import (
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+// Options are options to scaffold a typed event in a module
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	EventName  multiformatname.Name
+	Fields     field.Fields
+	EmitFrom   []EmitFrom
+}
+
+// EmitFrom is a scaffolded message handler that emits the event
+type EmitFrom struct {
+	// MsgName is the name of the message handled.
+	MsgName multiformatname.Name
+
+	// Path is the path of the file where the message handler is defined.
+	Path string
+}
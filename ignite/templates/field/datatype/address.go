@@ -0,0 +1,44 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+// DataAddress account address data type definition
+var DataAddress = DataType{
+	DataType:          func(string) string { return "string" },
+	DefaultTestSample: "sample.AccAddress()",
+	ValueLoop:         "strconv.Itoa(i)",
+	ValueIndex:        "strconv.Itoa(0)",
+	ValueInvalidIndex: "strconv.Itoa(100000)",
+	ProtoType: func(_, name string, index int) string {
+		return fmt.Sprintf("string %s = %d [(cosmos_proto.scalar) = \"cosmos.AddressString\"]", name, index)
+	},
+	GenesisArgs: func(name multiformatname.Name, _ string, value int) string {
+		return fmt.Sprintf("%s: \"%d\",\n", name.UpperCamel, value)
+	},
+	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+		return fmt.Sprintf("%s%s := args[%d]", prefix, name.UpperCamel, argIndex)
+	},
+	ValidateBasic: func(name multiformatname.Name, prefix string) string {
+		return fmt.Sprintf(`if %[1]v%[2]v != "" {
+		if _, err := sdk.AccAddressFromBech32(%[1]v%[2]v); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %[3]v address (%%s)", err)
+		}
+	}`, prefix, name.UpperCamel, name.LowerCamel)
+	},
+	ToBytes: func(name string) string {
+		return fmt.Sprintf("%[1]vBytes := []byte(%[1]v)", name)
+	},
+	ToString: func(name string) string {
+		return name
+	},
+	Rules: map[RuleName]RuleCheck{
+		RuleRequired: requiredRule(`%s == ""`),
+	},
+	RuleTestValue:     constTestValue("sample.AccAddress()"),
+	InvalidTestValues: constInvalidTestValues(`""`, ""),
+	ProtoImports:      []string{"cosmos_proto/cosmos.proto"},
+}
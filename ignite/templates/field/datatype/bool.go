@@ -16,7 +16,7 @@ var DataBool = DataType{
 	ProtoType: func(_, name string, index int) string {
 		return fmt.Sprintf("bool %s = %d", name, index)
 	},
-	GenesisArgs: func(name multiformatname.Name, value int) string {
+	GenesisArgs: func(name multiformatname.Name, _ string, value int) string {
 		return fmt.Sprintf("%s: %t,\n", name.UpperCamel, value%2 == 0)
 	},
 	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
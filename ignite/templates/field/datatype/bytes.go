@@ -0,0 +1,30 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+// DataBytes bytes data type definition, the bytes are hex encoded in the CLI arguments
+var DataBytes = DataType{
+	DataType:         func(string) string { return "[]byte" },
+	DefaultTestValue: "0a0b0c",
+	ProtoType: func(_, name string, index int) string {
+		return fmt.Sprintf("bytes %s = %d", name, index)
+	},
+	GenesisArgs: func(name multiformatname.Name, _ string, value int) string {
+		return fmt.Sprintf("%s: []byte(\"%d\"),\n", name.UpperCamel, value)
+	},
+	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+		return fmt.Sprintf(`%s%s, err := hex.DecodeString(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+	},
+	GoCLIImports:      []GoImport{{Name: "encoding/hex"}},
+	Rules:             lengthRules(),
+	RuleTestValue:     lengthTestValue(sliceTestValue),
+	InvalidTestValues: lengthInvalidTestValues(sliceTestValue),
+	NonIndex:          true,
+}
@@ -15,7 +15,7 @@ var (
 			return fmt.Sprintf("cosmos.base.v1beta1.Coin %s = %d [(gogoproto.nullable) = false]",
 				name, index)
 		},
-		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		GenesisArgs: func(multiformatname.Name, string, int) string { return "" },
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
 			return fmt.Sprintf(`%s%s, err := sdk.ParseCoinNormalized(args[%d])
 					if err != nil {
@@ -24,7 +24,17 @@ var (
 		},
 		GoCLIImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
 		ProtoImports: []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
-		NonIndex:     true,
+		Rules: map[RuleName]RuleCheck{
+			RuleRequired: requiredRule("%[1]s.Amount.IsNil() || %[1]s.IsZero()"),
+			RulePositive: positiveRule("%[1]s.Amount.IsNil() || !%[1]s.IsPositive()"),
+		},
+		RuleTestValue: constTestValue(`sdk.NewInt64Coin("token", 1)`),
+		InvalidTestValues: constInvalidTestValues(
+			"sdk.Coin{}",
+			`sdk.Coin{Denom: "token", Amount: sdk.NewInt(-1)}`,
+		),
+		RuleTestImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		NonIndex:        true,
 	}
 
 	// DataCoinSlice coin array data type definition
@@ -35,7 +45,7 @@ var (
 			return fmt.Sprintf("repeated cosmos.base.v1beta1.Coin %s = %d [(gogoproto.nullable) = false]",
 				name, index)
 		},
-		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		GenesisArgs: func(multiformatname.Name, string, int) string { return "" },
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
 			return fmt.Sprintf(`%s%s, err := sdk.ParseCoinsNormalized(args[%d])
 					if err != nil {
@@ -44,6 +54,16 @@ var (
 		},
 		GoCLIImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
 		ProtoImports: []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
-		NonIndex:     true,
+		Rules: map[RuleName]RuleCheck{
+			RuleRequired: requiredRule("len(%s) == 0"),
+			RulePositive: positiveRule("!%s.IsAllPositive()"),
+		},
+		RuleTestValue: constTestValue(`sdk.NewCoins(sdk.NewInt64Coin("token", 1))`),
+		InvalidTestValues: constInvalidTestValues(
+			"sdk.Coins{}",
+			`sdk.Coins{sdk.NewInt64Coin("token", 0)}`,
+		),
+		RuleTestImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		NonIndex:        true,
 	}
 )
@@ -2,27 +2,135 @@ package datatype
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ignite/cli/ignite/pkg/multiformatname"
 )
 
-// DataCustom custom data type definition
-var DataCustom = DataType{
-	DataType:         func(datatype string) string { return fmt.Sprintf("*%s", datatype) },
-	DefaultTestValue: "null",
-	ProtoType: func(datatype, name string, index int) string {
-		return fmt.Sprintf("%s %s = %d", datatype, name, index)
-	},
-	GenesisArgs: func(name multiformatname.Name, value int) string {
-		return fmt.Sprintf("%s: new(types.%s),\n", name.UpperCamel, name.UpperCamel)
-	},
-	CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
-		return fmt.Sprintf(`%[1]v%[2]v := new(types.%[3]v)
+// CustomMapKeys are the types that can be used as keys of the custom type maps
+// with their Go type, the proto scalar types of the keys have the same name
+var CustomMapKeys = map[Name]string{
+	String: "string",
+	Int:    "int32",
+	Uint:   "uint64",
+	Int64:  "int64",
+	Uint64: "uint64",
+}
+
+var (
+	// DataCustom custom data type definition
+	DataCustom = DataType{
+		DataType:         func(datatype string) string { return fmt.Sprintf("*%s", datatype) },
+		ExternalDataType: func(datatype string) string { return fmt.Sprintf("*types.%s", datatype) },
+		DefaultTestValue: "null",
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("%s %s = %d", datatype, name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, datatype string, _ int) string {
+			return fmt.Sprintf("%s: new(types.%s),\n", name.UpperCamel, datatype)
+		},
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]v%[2]v := new(types.%[3]v)
 					err = json.Unmarshal([]byte(args[%[4]v]), %[1]v%[2]v)
     				if err != nil {
                 		return err
             		}`, prefix, name.UpperCamel, datatype, argIndex)
-	},
-	GoCLIImports: []GoImport{{Name: "encoding/json"}},
-	NonIndex:     true,
+		},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}},
+		Rules: map[RuleName]RuleCheck{
+			RuleRequired: requiredRule("%s == nil"),
+		},
+		RuleTestValue: func(datatype string, _ []Rule) string {
+			return fmt.Sprintf("&%s{}", strings.TrimPrefix(datatype, "*"))
+		},
+		InvalidTestValues: constInvalidTestValues("nil", ""),
+		NonIndex:          true,
+	}
+
+	// DataCustomSlice custom data type array definition, the datatype is the custom type
+	DataCustomSlice = DataType{
+		DataType:         func(datatype string) string { return fmt.Sprintf("[]%s", datatype) },
+		ExternalDataType: func(datatype string) string { return fmt.Sprintf("[]types.%s", datatype) },
+		DefaultTestValue: "[]",
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("repeated %s %s = %d [(gogoproto.nullable) = false]", datatype, name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, datatype string, _ int) string {
+			return fmt.Sprintf("%s: []types.%s{{}},\n", name.UpperCamel, datatype)
+		},
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]v%[2]v := []types.%[3]v{}
+					err = json.Unmarshal([]byte(args[%[4]v]), &%[1]v%[2]v)
+    				if err != nil {
+                		return err
+            		}`, prefix, name.UpperCamel, datatype, argIndex)
+		},
+		GoCLIImports:      []GoImport{{Name: "encoding/json"}},
+		ProtoImports:      []string{"gogoproto/gogo.proto"},
+		Rules:             lengthRules(),
+		RuleTestValue:     lengthTestValue(sliceTestValue),
+		InvalidTestValues: lengthInvalidTestValues(sliceTestValue),
+		NonIndex:          true,
+	}
+
+	// DataCustomMap custom data type map definition, the datatype is the key type
+	// and the custom type separated by a dot, e.g. string.MyType
+	DataCustomMap = DataType{
+		DataType: func(datatype string) string {
+			key, value := SplitCustomMap(datatype)
+			return fmt.Sprintf("map[%s]%s", CustomMapKeys[key], value)
+		},
+		ExternalDataType: func(datatype string) string {
+			key, value := SplitCustomMap(datatype)
+			return fmt.Sprintf("map[%s]types.%s", CustomMapKeys[key], value)
+		},
+		DefaultTestValue: "{}",
+		ProtoType: func(datatype, name string, index int) string {
+			key, value := SplitCustomMap(datatype)
+			return fmt.Sprintf("map<%s, %s> %s = %d [(gogoproto.nullable) = false]",
+				CustomMapKeys[key], value, name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, datatype string, value int) string {
+			key, custom := SplitCustomMap(datatype)
+			mapKey := fmt.Sprintf("%d", value)
+			if key == String {
+				mapKey = strconv.Quote(mapKey)
+			}
+			return fmt.Sprintf("%s: map[%s]types.%s{%s: {}},\n", name.UpperCamel, CustomMapKeys[key], custom, mapKey)
+		},
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			key, value := SplitCustomMap(datatype)
+			return fmt.Sprintf(`%[1]v%[2]v := map[%[3]v]types.%[4]v{}
+					err = json.Unmarshal([]byte(args[%[5]v]), &%[1]v%[2]v)
+    				if err != nil {
+                		return err
+            		}`, prefix, name.UpperCamel, CustomMapKeys[key], value, argIndex)
+		},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}},
+		ProtoImports: []string{"gogoproto/gogo.proto"},
+		NonIndex:     true,
+	}
+)
+
+// SplitCustomMap returns the key type and the custom type of a custom type map datatype, e.g. string.MyType
+func SplitCustomMap(datatype string) (key Name, value string) {
+	key, value = String, datatype
+	if i := strings.Index(datatype, "."); i >= 0 {
+		key, value = Name(datatype[:i]), datatype[i+1:]
+	}
+	return key, value
+}
+
+// CustomType returns the custom type of a type name that is either a custom type,
+// an array of a custom type or a map of a custom type, e.g. MyType for map.string.MyType
+func CustomType(name Name) string {
+	switch {
+	case strings.HasPrefix(string(name), SlicePrefix):
+		return strings.TrimPrefix(string(name), SlicePrefix)
+	case strings.HasPrefix(string(name), MapPrefix):
+		_, value := SplitCustomMap(strings.TrimPrefix(string(name), MapPrefix))
+		return value
+	}
+	return string(name)
 }
@@ -0,0 +1,32 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+// DataDec decimal data type definition
+var DataDec = DataType{
+	DataType:         func(string) string { return "sdk.Dec" },
+	DefaultTestValue: "1.5",
+	ProtoType: func(_, name string, index int) string {
+		return fmt.Sprintf("string %s = %d [(cosmos_proto.scalar) = \"cosmos.Dec\", "+
+			"(gogoproto.customtype) = \"github.com/cosmos/cosmos-sdk/types.Dec\", (gogoproto.nullable) = false]",
+			name, index)
+	},
+	GenesisArgs: func(multiformatname.Name, string, int) string { return "" },
+	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+		return fmt.Sprintf(`%s%s, err := sdk.NewDecFromStr(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+	},
+	GoCLIImports:      []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+	ProtoImports:      []string{"gogoproto/gogo.proto", "cosmos_proto/cosmos.proto"},
+	Rules:             decType.rules(),
+	RuleTestValue:     decType.testValue,
+	InvalidTestValues: decType.invalidTestValues,
+	RuleTestImports:   []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+	NonIndex:          true,
+}
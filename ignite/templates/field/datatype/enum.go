@@ -0,0 +1,48 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+// DataEnum returns the data type definition of an enum with its name and values.
+// The Go type of the enum is generated from the proto enum in the types package of the module.
+// The numbers of the enum values go from 0 to the number of values minus one, the generated
+// values are kept in this range to always be valid enum values.
+func DataEnum(name string, values []multiformatname.Name) DataType {
+	return DataType{
+		DataType:         func(string) string { return name },
+		ExternalDataType: func(string) string { return fmt.Sprintf("types.%s", name) },
+		// the last value is used to not test with the default value of the enum
+		DefaultTestValue:  values[len(values)-1].Original,
+		ValueLoop:         fmt.Sprintf("types.%s(i %% %d)", name, len(values)),
+		ValueIndex:        "0",
+		ValueInvalidIndex: "100000",
+		ProtoType: func(_, fieldName string, index int) string {
+			return fmt.Sprintf("%s %s = %d", name, fieldName, index)
+		},
+		GenesisArgs: func(fieldName multiformatname.Name, _ string, value int) string {
+			return fmt.Sprintf("%s: %d,\n", fieldName.UpperCamel, value%len(values))
+		},
+		CLIArgs: func(fieldName multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := types.Parse%s(args[%d])
+            		if err != nil {
+                		return err
+            		}`,
+				prefix, fieldName.UpperCamel, name, argIndex)
+		},
+		ValidateBasic: func(fieldName multiformatname.Name, prefix string) string {
+			return fmt.Sprintf(`if err := %[1]v%[2]v.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %[3]v (%%s)", err)
+	}`, prefix, fieldName.UpperCamel, fieldName.LowerCamel)
+		},
+		ToBytes: func(name string) string {
+			return fmt.Sprintf(`%[1]vBytes := make([]byte, 4)
+  					binary.BigEndian.PutUint32(%[1]vBytes, uint32(%[1]v))`, name)
+		},
+		ToString: func(name string) string {
+			return fmt.Sprintf("%s.String()", name)
+		},
+	}
+}
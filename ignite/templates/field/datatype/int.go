@@ -17,7 +17,7 @@ var (
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("int32 %s = %d", name, index)
 		},
-		GenesisArgs: func(name multiformatname.Name, value int) string {
+		GenesisArgs: func(name multiformatname.Name, _ string, value int) string {
 			return fmt.Sprintf("%s: %d,\n", name.UpperCamel, value)
 		},
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
@@ -34,7 +34,43 @@ var (
 		ToString: func(name string) string {
 			return fmt.Sprintf("strconv.Itoa(int(%s))", name)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+		GoCLIImports:      []GoImport{{Name: "github.com/spf13/cast"}},
+		Rules:             intType(32, false).rules(),
+		RuleTestValue:     intType(32, false).testValue,
+		InvalidTestValues: intType(32, false).invalidTestValues,
+	}
+
+	// DataInt64 int64 data type definition
+	DataInt64 = DataType{
+		DataType:          func(string) string { return "int64" },
+		DefaultTestValue:  "111",
+		ValueLoop:         "int64(i)",
+		ValueIndex:        "0",
+		ValueInvalidIndex: "100000",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("int64 %s = %d", name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, _ string, value int) string {
+			return fmt.Sprintf("%s: %d,\n", name.UpperCamel, value)
+		},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := cast.ToInt64E(args[%d])
+            		if err != nil {
+                		return err
+            		}`,
+				prefix, name.UpperCamel, argIndex)
+		},
+		ToBytes: func(name string) string {
+			return fmt.Sprintf(`%[1]vBytes := make([]byte, 8)
+  					binary.BigEndian.PutUint64(%[1]vBytes, uint64(%[1]v))`, name)
+		},
+		ToString: func(name string) string {
+			return fmt.Sprintf("strconv.FormatInt(%s, 10)", name)
+		},
+		GoCLIImports:      []GoImport{{Name: "github.com/spf13/cast"}},
+		Rules:             intType(64, false).rules(),
+		RuleTestValue:     intType(64, false).testValue,
+		InvalidTestValues: intType(64, false).invalidTestValues,
 	}
 
 	// DataIntSlice int array data type definition
@@ -44,7 +80,7 @@ var (
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated int32 %s = %d", name, index)
 		},
-		GenesisArgs: func(name multiformatname.Name, value int) string {
+		GenesisArgs: func(name multiformatname.Name, _ string, value int) string {
 			return fmt.Sprintf("%s: []int32{%d},\n", name.UpperCamel, value)
 		},
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
@@ -58,7 +94,10 @@ var (
 						%[1]v%[2]v[i] = value
 					}`, prefix, name.UpperCamel, argIndex)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
-		NonIndex:     true,
+		GoCLIImports:      []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
+		Rules:             lengthRules(),
+		RuleTestValue:     lengthTestValue(sliceTestValue),
+		InvalidTestValues: lengthInvalidTestValues(sliceTestValue),
+		NonIndex:          true,
 	}
 )
@@ -0,0 +1,511 @@
+package datatype
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// RuleSeparator represents the separator of the validation rules of a field
+	RuleSeparator = ","
+	// RuleArgSeparator represents the separator between a validation rule and its argument
+	RuleArgSeparator = "="
+	// RangeSeparator represents the separator of the bounds of the range rule
+	RangeSeparator = ".."
+)
+
+const (
+	// RuleRequired represents the rule of a non-zero value
+	RuleRequired RuleName = "required"
+	// RuleMin represents the rule of a minimum value or length
+	RuleMin RuleName = "min"
+	// RuleMax represents the rule of a maximum value or length
+	RuleMax RuleName = "max"
+	// RulePositive represents the rule of a positive value
+	RulePositive RuleName = "positive"
+	// RuleRange represents the rule of a value between two bounds with the format 'min..max'
+	RuleRange RuleName = "range"
+)
+
+const (
+	// ErrFieldRequired is the typed error returned when a required field is empty
+	ErrFieldRequired = "ErrFieldRequired"
+	// ErrFieldTooSmall is the typed error returned when a field value or length is too small
+	ErrFieldTooSmall = "ErrFieldTooSmall"
+	// ErrFieldTooLarge is the typed error returned when a field value or length is too large
+	ErrFieldTooLarge = "ErrFieldTooLarge"
+	// ErrFieldNotPositive is the typed error returned when a field value is not positive
+	ErrFieldNotPositive = "ErrFieldNotPositive"
+)
+
+// decRegexp matches the decimal arguments of the rules
+var decRegexp = regexp.MustCompile(`^-?[0-9]+(\.[0-9]{1,18})?$`)
+
+// RuleName represents the name of a field validation rule
+type RuleName string
+
+// Rule represents a validation rule of a field with its argument, e.g. 'max=64'
+type Rule struct {
+	Name RuleName
+	Arg  string
+}
+
+// String returns the rule with the format of the field
+func (r Rule) String() string {
+	if r.Arg == "" {
+		return string(r.Name)
+	}
+	return string(r.Name) + RuleArgSeparator + r.Arg
+}
+
+// RuleCheck represents the code generation of a validation rule for a data type
+type RuleCheck struct {
+	// ValidateArg validates the argument of the rule, the rule has no argument when it's nil
+	ValidateArg func(arg string) error
+	// ValidateBasic returns the check of the rule in the message ValidateBasic method
+	ValidateBasic func(value, name, arg string) string
+}
+
+// InvalidTestValue represents a test value of a field that doesn't satisfy one of its validation
+// rules with the typed error returned by the checks of the field rules
+type InvalidTestValue struct {
+	Rule  Rule
+	Value string
+	Err   string
+}
+
+// ruleOrder is the order of the rule checks, the required and positive rules are checked first
+var ruleOrder = map[RuleName]int{
+	RuleRequired: 0,
+	RulePositive: 1,
+	RuleMin:      2,
+	RuleMax:      3,
+	RuleRange:    4,
+}
+
+// SortRules sorts the validation rules of a field in the order of their checks
+func SortRules(rules []Rule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		return ruleOrder[rules[i].Name] < ruleOrder[rules[j].Name]
+	})
+}
+
+// ruleCheck returns the code returning the typed error when the condition is true
+func ruleCheck(condition, err, msg string) string {
+	return fmt.Sprintf(`if %s {
+		return sdkerrors.Wrap(%s, "%s")
+	}`, condition, err, msg)
+}
+
+// requiredRule returns the required rule, isZero is the condition of an empty value with the value as argument
+func requiredRule(isZero string) RuleCheck {
+	return RuleCheck{
+		ValidateBasic: func(value, name, _ string) string {
+			return ruleCheck(fmt.Sprintf(isZero, value), ErrFieldRequired, name)
+		},
+	}
+}
+
+// positiveRule returns the positive rule, isNotPositive is the condition of a non-positive value with the value as argument
+func positiveRule(isNotPositive string) RuleCheck {
+	return RuleCheck{
+		ValidateBasic: func(value, name, _ string) string {
+			return ruleCheck(fmt.Sprintf(isNotPositive, value), ErrFieldNotPositive, name)
+		},
+	}
+}
+
+// constTestValue returns a test value function that always returns the value
+func constTestValue(value string) func(string, []Rule) string {
+	return func(string, []Rule) string { return value }
+}
+
+// constInvalidTestValues returns the invalid test values of the required and positive rules,
+// each value satisfies the other rule so the typed error is the error of its rule
+func constInvalidTestValues(required, notPositive string) func(string, []Rule) []InvalidTestValue {
+	return func(_ string, rules []Rule) []InvalidTestValue {
+		values := make([]InvalidTestValue, 0, len(rules))
+		for _, rule := range rules {
+			switch rule.Name {
+			case RuleRequired:
+				values = append(values, InvalidTestValue{Rule: rule, Value: required, Err: ErrFieldRequired})
+			case RulePositive:
+				values = append(values, InvalidTestValue{Rule: rule, Value: notPositive, Err: ErrFieldNotPositive})
+			}
+		}
+		return values
+	}
+}
+
+// lengthRules returns the required, min and max rules checking the length of a value
+func lengthRules() map[RuleName]RuleCheck {
+	return map[RuleName]RuleCheck{
+		RuleRequired: {
+			ValidateBasic: func(value, name, _ string) string {
+				return ruleCheck(fmt.Sprintf("len(%s) == 0", value), ErrFieldRequired, name)
+			},
+		},
+		RuleMin: {
+			ValidateArg: func(arg string) error {
+				if n, err := strconv.Atoi(arg); err != nil || n < 1 {
+					return errors.New("the minimum length should be a positive integer")
+				}
+				return nil
+			},
+			ValidateBasic: func(value, name, arg string) string {
+				return ruleCheck(
+					fmt.Sprintf("len(%s) < %s", value, arg),
+					ErrFieldTooSmall,
+					fmt.Sprintf("%s length should be at least %s", name, arg),
+				)
+			},
+		},
+		RuleMax: {
+			ValidateArg: func(arg string) error {
+				if n, err := strconv.Atoi(arg); err != nil || n < 0 {
+					return errors.New("the maximum length should be a non-negative integer")
+				}
+				return nil
+			},
+			ValidateBasic: func(value, name, arg string) string {
+				return ruleCheck(
+					fmt.Sprintf("len(%s) > %s", value, arg),
+					ErrFieldTooLarge,
+					fmt.Sprintf("%s length should be at most %s", name, arg),
+				)
+			},
+		},
+	}
+}
+
+// lengthErr returns the typed error of the first length rule that the length n doesn't satisfy
+func lengthErr(rules []Rule, n int) string {
+	for _, rule := range rules {
+		arg, _ := strconv.Atoi(rule.Arg)
+		switch {
+		case rule.Name == RuleRequired && n == 0:
+			return ErrFieldRequired
+		case rule.Name == RuleMin && n < arg:
+			return ErrFieldTooSmall
+		case rule.Name == RuleMax && n > arg:
+			return ErrFieldTooLarge
+		}
+	}
+	return ""
+}
+
+// lengthTestValue returns a test value satisfying the length rules,
+// testValue returns a value of the data type with the length n
+func lengthTestValue(testValue func(datatype string, n int) string) func(string, []Rule) string {
+	return func(datatype string, rules []Rule) string {
+		n := 0
+		for _, rule := range rules {
+			switch rule.Name {
+			case RuleRequired:
+				if n < 1 {
+					n = 1
+				}
+			case RuleMin:
+				if min, _ := strconv.Atoi(rule.Arg); min > n {
+					n = min
+				}
+			}
+		}
+		return testValue(datatype, n)
+	}
+}
+
+// lengthInvalidTestValues returns the invalid test values of the length rules,
+// testValue returns a value of the data type with the length n
+func lengthInvalidTestValues(testValue func(datatype string, n int) string) func(string, []Rule) []InvalidTestValue {
+	return func(datatype string, rules []Rule) []InvalidTestValue {
+		values := make([]InvalidTestValue, 0, len(rules))
+		for _, rule := range rules {
+			n, _ := strconv.Atoi(rule.Arg)
+			switch rule.Name {
+			case RuleRequired:
+				n = 0
+			case RuleMin:
+				n--
+			case RuleMax:
+				n++
+			}
+			values = append(values, InvalidTestValue{
+				Rule:  rule,
+				Value: testValue(datatype, n),
+				Err:   lengthErr(rules, n),
+			})
+		}
+		return values
+	}
+}
+
+// numberType represents the code generation of the rules of a number type, the conditions
+// are formatted with the value as first argument and the bound as second argument
+type numberType struct {
+	parse         func(arg string) error
+	literal       func(value *big.Rat) string
+	unsigned      bool
+	isZero        string
+	isNotPositive string
+	isLessThan    string
+	isGreaterThan string
+}
+
+// intType returns the number type of an integer with the bit size
+func intType(bitSize int, unsigned bool) numberType {
+	n := numberType{
+		parse: func(arg string) error {
+			if _, err := strconv.ParseInt(arg, 10, bitSize); err != nil {
+				return fmt.Errorf("%s is not a valid int%d", arg, bitSize)
+			}
+			return nil
+		},
+		literal:       func(value *big.Rat) string { return value.RatString() },
+		isZero:        "%[1]s == 0",
+		isNotPositive: "%[1]s <= 0",
+		isLessThan:    "%[1]s < %[2]s",
+		isGreaterThan: "%[1]s > %[2]s",
+	}
+	if unsigned {
+		n.unsigned = true
+		n.isNotPositive = "%[1]s == 0"
+		n.parse = func(arg string) error {
+			if _, err := strconv.ParseUint(arg, 10, bitSize); err != nil {
+				return fmt.Errorf("%s is not a valid uint%d", arg, bitSize)
+			}
+			return nil
+		}
+	}
+	return n
+}
+
+// decType is the number type of the decimals, an empty decimal doesn't satisfy the rules
+var decType = numberType{
+	parse: func(arg string) error {
+		if !decRegexp.MatchString(arg) {
+			return fmt.Errorf("%s is not a valid decimal", arg)
+		}
+		return nil
+	},
+	literal: func(value *big.Rat) string {
+		s := value.FloatString(18)
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+		return fmt.Sprintf(`sdk.MustNewDecFromStr("%s")`, s)
+	},
+	isZero:        "%[1]s.IsNil() || %[1]s.IsZero()",
+	isNotPositive: "%[1]s.IsNil() || !%[1]s.IsPositive()",
+	isLessThan:    "%[1]s.IsNil() || %[1]s.LT(%[2]s)",
+	isGreaterThan: "%[1]s.IsNil() || %[1]s.GT(%[2]s)",
+}
+
+// rat returns the rational value of a rule argument, the argument is already validated
+func rat(arg string) *big.Rat {
+	r, ok := new(big.Rat).SetString(arg)
+	if !ok {
+		return new(big.Rat)
+	}
+	return r
+}
+
+// splitRange returns the bounds of the range rule argument
+func splitRange(arg string) (min, max string) {
+	bounds := strings.SplitN(arg, RangeSeparator, 2)
+	if len(bounds) != 2 {
+		return arg, ""
+	}
+	return bounds[0], bounds[1]
+}
+
+// rules returns the required, min, max, positive and range rules of the number type
+func (n numberType) rules() map[RuleName]RuleCheck {
+	literal := func(arg string) string { return n.literal(rat(arg)) }
+	return map[RuleName]RuleCheck{
+		RuleRequired: {
+			ValidateBasic: func(value, name, _ string) string {
+				return ruleCheck(fmt.Sprintf(n.isZero, value), ErrFieldRequired, name)
+			},
+		},
+		RulePositive: {
+			ValidateBasic: func(value, name, _ string) string {
+				return ruleCheck(fmt.Sprintf(n.isNotPositive, value), ErrFieldNotPositive, name)
+			},
+		},
+		RuleMin: {
+			ValidateArg: func(arg string) error {
+				if err := n.parse(arg); err != nil {
+					return err
+				}
+				if n.unsigned && rat(arg).Sign() == 0 {
+					return errors.New("the minimum of an unsigned integer should be greater than 0")
+				}
+				return nil
+			},
+			ValidateBasic: func(value, name, arg string) string {
+				return ruleCheck(
+					fmt.Sprintf(n.isLessThan, value, literal(arg)),
+					ErrFieldTooSmall,
+					fmt.Sprintf("%s should be at least %s", name, arg),
+				)
+			},
+		},
+		RuleMax: {
+			ValidateArg: n.parse,
+			ValidateBasic: func(value, name, arg string) string {
+				return ruleCheck(
+					fmt.Sprintf(n.isGreaterThan, value, literal(arg)),
+					ErrFieldTooLarge,
+					fmt.Sprintf("%s should be at most %s", name, arg),
+				)
+			},
+		},
+		RuleRange: {
+			ValidateArg: func(arg string) error {
+				min, max := splitRange(arg)
+				if min == "" || max == "" {
+					return fmt.Errorf("the range should have the format 'min%smax'", RangeSeparator)
+				}
+				if err := n.parse(min); err != nil {
+					return err
+				}
+				if err := n.parse(max); err != nil {
+					return err
+				}
+				if rat(min).Cmp(rat(max)) > 0 {
+					return fmt.Errorf("the range minimum %s is greater than the maximum %s", min, max)
+				}
+				return nil
+			},
+			ValidateBasic: func(value, name, arg string) string {
+				min, max := splitRange(arg)
+				msg := fmt.Sprintf("%s should be between %s and %s", name, min, max)
+				return ruleCheck(fmt.Sprintf(n.isLessThan, value, literal(min)), ErrFieldTooSmall, msg) + "\n" +
+					ruleCheck(fmt.Sprintf(n.isGreaterThan, value, literal(max)), ErrFieldTooLarge, msg)
+			},
+		},
+	}
+}
+
+// err returns the typed error of the first rule that the value doesn't satisfy
+func (n numberType) err(rules []Rule, value *big.Rat) string {
+	for _, rule := range rules {
+		switch rule.Name {
+		case RuleRequired:
+			if value.Sign() == 0 {
+				return ErrFieldRequired
+			}
+		case RulePositive:
+			if value.Sign() <= 0 {
+				return ErrFieldNotPositive
+			}
+		case RuleMin:
+			if value.Cmp(rat(rule.Arg)) < 0 {
+				return ErrFieldTooSmall
+			}
+		case RuleMax:
+			if value.Cmp(rat(rule.Arg)) > 0 {
+				return ErrFieldTooLarge
+			}
+		case RuleRange:
+			min, max := splitRange(rule.Arg)
+			if value.Cmp(rat(min)) < 0 {
+				return ErrFieldTooSmall
+			}
+			if value.Cmp(rat(max)) > 0 {
+				return ErrFieldTooLarge
+			}
+		}
+	}
+	return ""
+}
+
+// testValue returns a test value satisfying the rules of the number type
+func (n numberType) testValue(_ string, rules []Rule) string {
+	var (
+		lower, upper *big.Rat
+		nonZero      bool
+	)
+	setLower := func(v *big.Rat) {
+		if lower == nil || v.Cmp(lower) > 0 {
+			lower = v
+		}
+	}
+	setUpper := func(v *big.Rat) {
+		if upper == nil || v.Cmp(upper) < 0 {
+			upper = v
+		}
+	}
+	for _, rule := range rules {
+		switch rule.Name {
+		case RuleRequired:
+			nonZero = true
+		case RulePositive:
+			nonZero = true
+			setLower(new(big.Rat))
+		case RuleMin:
+			setLower(rat(rule.Arg))
+		case RuleMax:
+			setUpper(rat(rule.Arg))
+		case RuleRange:
+			min, max := splitRange(rule.Arg)
+			setLower(rat(min))
+			setUpper(rat(max))
+		}
+	}
+
+	value := new(big.Rat)
+	switch {
+	case lower != nil:
+		value = lower
+	case upper != nil:
+		value = upper
+	}
+
+	// Zero is a bound of the rules, a value between the bounds is used instead
+	one := big.NewRat(1, 1)
+	if nonZero && value.Sign() == 0 {
+		switch {
+		case upper == nil || upper.Cmp(one) >= 0:
+			value = one
+		case upper.Sign() > 0:
+			value = upper
+		default:
+			value = big.NewRat(-1, 1)
+		}
+	}
+	return n.literal(value)
+}
+
+// invalidTestValues returns the invalid test values of the rules of the number type
+func (n numberType) invalidTestValues(_ string, rules []Rule) []InvalidTestValue {
+	one := big.NewRat(1, 1)
+	values := make([]InvalidTestValue, 0, len(rules))
+	for _, rule := range rules {
+		value := new(big.Rat)
+		switch rule.Name {
+		case RulePositive:
+			// A negative value satisfies the required rule
+			if !n.unsigned {
+				value.Neg(one)
+			}
+		case RuleMin:
+			value.Sub(rat(rule.Arg), one)
+		case RuleMax:
+			value.Add(rat(rule.Arg), one)
+		case RuleRange:
+			_, max := splitRange(rule.Arg)
+			value.Add(rat(max), one)
+		}
+		values = append(values, InvalidTestValue{
+			Rule:  rule,
+			Value: n.literal(value),
+			Err:   n.err(rules, value),
+		})
+	}
+	return values
+}
@@ -17,7 +17,7 @@ var (
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("string %s = %d", name, index)
 		},
-		GenesisArgs: func(name multiformatname.Name, value int) string {
+		GenesisArgs: func(name multiformatname.Name, _ string, value int) string {
 			return fmt.Sprintf("%s: \"%d\",\n", name.UpperCamel, value)
 		},
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
@@ -29,6 +29,10 @@ var (
 		ToString: func(name string) string {
 			return name
 		},
+		Rules:             lengthRules(),
+		RuleTestValue:     lengthTestValue(stringTestValue),
+		InvalidTestValues: lengthInvalidTestValues(stringTestValue),
+		RuleTestImports:   []GoImport{{Name: "strings"}},
 	}
 
 	// DataStringSlice string array data type definition
@@ -38,14 +42,30 @@ var (
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated string %s = %d", name, index)
 		},
-		GenesisArgs: func(name multiformatname.Name, value int) string {
+		GenesisArgs: func(name multiformatname.Name, _ string, value int) string {
 			return fmt.Sprintf("%s: []string{\"%d\"},\n", name.UpperCamel, value)
 		},
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
 			return fmt.Sprintf(`%[1]v%[2]v := strings.Split(args[%[3]v], listSeparator)`,
 				prefix, name.UpperCamel, argIndex)
 		},
-		GoCLIImports: []GoImport{{Name: "strings"}},
-		NonIndex:     true,
+		GoCLIImports:      []GoImport{{Name: "strings"}},
+		Rules:             lengthRules(),
+		RuleTestValue:     lengthTestValue(sliceTestValue),
+		InvalidTestValues: lengthInvalidTestValues(sliceTestValue),
+		NonIndex:          true,
 	}
 )
+
+// stringTestValue returns a test string with the length n
+func stringTestValue(_ string, n int) string {
+	if n == 0 {
+		return `""`
+	}
+	return fmt.Sprintf(`strings.Repeat("a", %d)`, n)
+}
+
+// sliceTestValue returns a test value with the length n of an array data type
+func sliceTestValue(datatype string, n int) string {
+	return fmt.Sprintf("make(%s, %d)", datatype, n)
+}
@@ -0,0 +1,51 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+var (
+	// DataTimestamp timestamp data type definition, the CLI arguments use the RFC 3339 format
+	DataTimestamp = DataType{
+		DataType:         func(string) string { return "time.Time" },
+		DefaultTestValue: "2022-01-01T00:00:00Z",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("google.protobuf.Timestamp %s = %d [(gogoproto.nullable) = false, (gogoproto.stdtime) = true]",
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, string, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.Parse(time.RFC3339, args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		GoCLIImports:   []GoImport{{Name: "time"}},
+		GoTypesImports: []GoImport{{Name: "time"}},
+		ProtoImports:   []string{"gogoproto/gogo.proto", "google/protobuf/timestamp.proto"},
+		NonIndex:       true,
+	}
+
+	// DataDuration duration data type definition, the CLI arguments use the Go duration format, e.g. 1h30m
+	DataDuration = DataType{
+		DataType:         func(string) string { return "time.Duration" },
+		DefaultTestValue: "1h30m",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("google.protobuf.Duration %s = %d [(gogoproto.nullable) = false, (gogoproto.stdduration) = true]",
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, string, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.ParseDuration(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		GoCLIImports:   []GoImport{{Name: "time"}},
+		GoTypesImports: []GoImport{{Name: "time"}},
+		ProtoImports:   []string{"gogoproto/gogo.proto", "google/protobuf/duration.proto"},
+		NonIndex:       true,
+	}
+)
@@ -7,6 +7,12 @@ import (
 const (
 	// Separator represents the type separator
 	Separator = ":"
+	// EnumValueSeparator represents the enum values separator
+	EnumValueSeparator = "|"
+	// SlicePrefix represents the prefix of the array type names
+	SlicePrefix = "array."
+	// MapPrefix represents the prefix of the map type names
+	MapPrefix = "map."
 )
 
 const (
@@ -28,8 +34,28 @@ const (
 	Coin Name = "coin"
 	// Coins represents the coin array type name
 	Coins Name = "array.coin"
+	// Int64 represents the int64 type name
+	Int64 Name = "int64"
+	// Uint64 represents the uint64 type name
+	Uint64 Name = "uint64"
+	// Address represents the account address type name
+	Address Name = "address"
+	// Dec represents the decimal type name
+	Dec Name = "dec"
+	// Bytes represents the bytes type name
+	Bytes Name = "bytes"
+	// Timestamp represents the timestamp type name
+	Timestamp Name = "timestamp"
+	// Duration represents the duration type name
+	Duration Name = "duration"
+	// Enum represents the enum type name
+	Enum Name = "enum"
 	// Custom represents the custom type name
 	Custom Name = Name(TypeCustom)
+	// CustomSlice represents the custom type array name
+	CustomSlice Name = Name(SlicePrefix + TypeCustom)
+	// CustomMap represents the custom type map name
+	CustomMap Name = Name(MapPrefix + TypeCustom)
 
 	// StringSliceAlias represents the string array type name alias
 	StringSliceAlias Name = "strings"
@@ -59,7 +85,16 @@ var SupportedTypes = map[Name]DataType{
 	Coin:             DataCoin,
 	Coins:            DataCoinSlice,
 	CoinSliceAlias:   DataCoinSlice,
+	Int64:            DataInt64,
+	Uint64:           DataUint,
+	Address:          DataAddress,
+	Dec:              DataDec,
+	Bytes:            DataBytes,
+	Timestamp:        DataTimestamp,
+	Duration:         DataDuration,
 	Custom:           DataCustom,
+	CustomSlice:      DataCustomSlice,
+	CustomMap:        DataCustomMap,
 }
 
 // Name represents the Alias Name for the data type
@@ -68,17 +103,25 @@ type Name string
 // DataType represents the data types for code replacement
 type DataType struct {
 	DataType          func(datatype string) string
+	ExternalDataType  func(datatype string) string
 	ProtoType         func(datatype, name string, index int) string
-	GenesisArgs       func(name multiformatname.Name, value int) string
+	GenesisArgs       func(name multiformatname.Name, datatype string, value int) string
 	ProtoImports      []string
 	GoCLIImports      []GoImport
+	GoTypesImports    []GoImport
 	DefaultTestValue  string
+	DefaultTestSample string
 	ValueLoop         string
 	ValueIndex        string
 	ValueInvalidIndex string
 	ToBytes           func(name string) string
 	ToString          func(name string) string
 	CLIArgs           func(name multiformatname.Name, datatype, prefix string, argIndex int) string
+	ValidateBasic     func(name multiformatname.Name, prefix string) string
+	Rules             map[RuleName]RuleCheck
+	RuleTestValue     func(datatype string, rules []Rule) string
+	InvalidTestValues func(datatype string, rules []Rule) []InvalidTestValue
+	RuleTestImports   []GoImport
 	NonIndex          bool
 }
 
@@ -17,7 +17,7 @@ var (
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("uint64 %s = %d", name, index)
 		},
-		GenesisArgs: func(name multiformatname.Name, value int) string {
+		GenesisArgs: func(name multiformatname.Name, _ string, value int) string {
 			return fmt.Sprintf("%s: %d,\n", name.UpperCamel, value)
 		},
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
@@ -34,7 +34,10 @@ var (
 		ToString: func(name string) string {
 			return fmt.Sprintf("strconv.Itoa(int(%s))", name)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+		GoCLIImports:      []GoImport{{Name: "github.com/spf13/cast"}},
+		Rules:             intType(64, true).rules(),
+		RuleTestValue:     intType(64, true).testValue,
+		InvalidTestValues: intType(64, true).invalidTestValues,
 	}
 
 	// DataUintSlice uint array data type definition
@@ -44,7 +47,7 @@ var (
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated uint64 %s = %d", name, index)
 		},
-		GenesisArgs: func(name multiformatname.Name, value int) string {
+		GenesisArgs: func(name multiformatname.Name, _ string, value int) string {
 			return fmt.Sprintf("%s: []uint64{%d},\n", name.UpperCamel, value)
 		},
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
@@ -59,7 +62,10 @@ var (
 					}`,
 				prefix, name.UpperCamel, argIndex)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
-		NonIndex:     true,
+		GoCLIImports:      []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
+		Rules:             lengthRules(),
+		RuleTestValue:     lengthTestValue(sliceTestValue),
+		InvalidTestValues: lengthInvalidTestValues(sliceTestValue),
+		NonIndex:          true,
 	}
 )
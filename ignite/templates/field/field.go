@@ -3,6 +3,8 @@ package field
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ignite/cli/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/ignite/templates/field/datatype"
@@ -14,15 +16,39 @@ type Field struct {
 	Name         multiformatname.Name
 	DatatypeName datatype.Name
 	Datatype     string
+	EnumValues   []multiformatname.Name
+	Rules        []datatype.Rule
 }
 
-// DataType returns the field Datatype
-func (f Field) DataType() string {
+// dataType returns the data type definition of the field
+func (f Field) dataType() datatype.DataType {
+	if f.DatatypeName == datatype.Enum {
+		return datatype.DataEnum(f.Datatype, f.EnumValues)
+	}
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
-	return dt.DataType(f.Datatype)
+	return dt
+}
+
+// DataType returns the field Datatype
+func (f Field) DataType() string {
+	return f.dataType().DataType(f.Datatype)
+}
+
+// ExternalDataType returns the field Datatype used outside the types package of the module
+func (f Field) ExternalDataType() string {
+	dt := f.dataType()
+	if dt.ExternalDataType == nil {
+		return dt.DataType(f.Datatype)
+	}
+	return dt.ExternalDataType(f.Datatype)
+}
+
+// IsEnum returns true if the field Datatype is an enum
+func (f Field) IsEnum() bool {
+	return f.DatatypeName == datatype.Enum
 }
 
 // ProtoFieldName returns the field name used in proto
@@ -32,28 +58,21 @@ func (f Field) ProtoFieldName() string {
 
 // ProtoType returns the field proto Datatype
 func (f Field) ProtoType(index int) string {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
-	}
-	return dt.ProtoType(f.Datatype, f.ProtoFieldName(), index)
+	return f.dataType().ProtoType(f.Datatype, f.ProtoFieldName(), index)
 }
 
-// DefaultTestValue returns the Datatype value default
+// DefaultTestValue returns the Go expression of the Datatype default test value
 func (f Field) DefaultTestValue() string {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	dt := f.dataType()
+	if dt.DefaultTestSample != "" {
+		return dt.DefaultTestSample
 	}
-	return dt.DefaultTestValue
+	return strconv.Quote(dt.DefaultTestValue)
 }
 
 // ValueLoop returns the Datatype value for loop iteration
 func (f Field) ValueLoop() string {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
-	}
+	dt := f.dataType()
 	if dt.NonIndex {
 		panic(fmt.Sprintf("non index type %s", f.DatatypeName))
 	}
@@ -62,10 +81,7 @@ func (f Field) ValueLoop() string {
 
 // ValueIndex returns the Datatype value for indexes
 func (f Field) ValueIndex() string {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
-	}
+	dt := f.dataType()
 	if dt.NonIndex {
 		panic(fmt.Sprintf("non index type %s", f.DatatypeName))
 	}
@@ -74,10 +90,7 @@ func (f Field) ValueIndex() string {
 
 // ValueInvalidIndex returns the Datatype value for invalid indexes
 func (f Field) ValueInvalidIndex() string {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
-	}
+	dt := f.dataType()
 	if dt.NonIndex {
 		panic(fmt.Sprintf("non index type %s", f.DatatypeName))
 	}
@@ -86,28 +99,17 @@ func (f Field) ValueInvalidIndex() string {
 
 // GenesisArgs returns the Datatype genesis args
 func (f Field) GenesisArgs(value int) string {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
-	}
-	return dt.GenesisArgs(f.Name, value)
+	return f.dataType().GenesisArgs(f.Name, f.Datatype, value)
 }
 
 // CLIArgs returns the Datatype CLI args
 func (f Field) CLIArgs(prefix string, argIndex int) string {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
-	}
-	return dt.CLIArgs(f.Name, f.Datatype, prefix, argIndex)
+	return f.dataType().CLIArgs(f.Name, f.Datatype, prefix, argIndex)
 }
 
 // ToBytes returns the Datatype byte array cast
 func (f Field) ToBytes(name string) string {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
-	}
+	dt := f.dataType()
 	if dt.NonIndex {
 		panic(fmt.Sprintf("non index type %s", f.DatatypeName))
 	}
@@ -116,30 +118,57 @@ func (f Field) ToBytes(name string) string {
 
 // ToString returns the Datatype byte array cast
 func (f Field) ToString(name string) string {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
-	}
+	dt := f.dataType()
 	if dt.NonIndex {
 		panic(fmt.Sprintf("non index type %s", f.DatatypeName))
 	}
 	return dt.ToString(name)
 }
 
+// ValidateBasic returns the Datatype checks and the validation rule checks of the field value
+// in the message ValidateBasic method. An empty string is returned when the field has no checks.
+func (f Field) ValidateBasic(prefix string) string {
+	dt := f.dataType()
+	var checks []string
+	if dt.ValidateBasic != nil {
+		checks = append(checks, dt.ValidateBasic(f.Name, prefix))
+	}
+	value := prefix + f.Name.UpperCamel
+	for _, rule := range f.Rules {
+		checks = append(checks, dt.Rules[rule.Name].ValidateBasic(value, f.Name.LowerCamel, rule.Arg))
+	}
+	return strings.Join(checks, "\n")
+}
+
+// HasRules returns true if the field has validation rules
+func (f Field) HasRules() bool {
+	return len(f.Rules) > 0
+}
+
+// RuleTestValue returns a test value of the field that satisfies its validation rules
+func (f Field) RuleTestValue() string {
+	return f.dataType().RuleTestValue(f.DataType(), f.Rules)
+}
+
+// RuleTestImports returns the Datatype imports of the validation rule test values
+func (f Field) RuleTestImports() []datatype.GoImport {
+	if !f.HasRules() {
+		return nil
+	}
+	return f.dataType().RuleTestImports
+}
+
+// GoTypesImports returns the Datatype imports for the types package
+func (f Field) GoTypesImports() []datatype.GoImport {
+	return f.dataType().GoTypesImports
+}
+
 // GoCLIImports returns the Datatype imports for CLI package
 func (f Field) GoCLIImports() []datatype.GoImport {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
-	}
-	return dt.GoCLIImports
+	return f.dataType().GoCLIImports
 }
 
 // ProtoImports return the Datatype imports for proto files
 func (f Field) ProtoImports() []string {
-	dt, ok := datatype.SupportedTypes[f.DatatypeName]
-	if !ok {
-		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
-	}
-	return dt.ProtoImports
+	return f.dataType().ProtoImports
 }
@@ -0,0 +1,42 @@
+package field
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+)
+
+func TestFieldDefaultTestValue(t *testing.T) {
+	fields, err := ParseFields([]string{"name", "count:uint", "owner:address"}, noCheck)
+	require.NoError(t, err)
+
+	require.Equal(t, `"xyz"`, fields[0].DefaultTestValue())
+	require.Equal(t, `"111"`, fields[1].DefaultTestValue())
+	require.Equal(t, "sample.AccAddress()", fields[2].DefaultTestValue())
+
+	require.True(t, fields.HasDefaultTestSample())
+	require.False(t, fields[:2].HasDefaultTestSample())
+	require.Equal(t, datatype.Address, fields[2].DatatypeName)
+}
+
+func TestFieldEnumValues(t *testing.T) {
+	fields, err := ParseFields([]string{"status:enum:Pending|Active|Closed"}, noCheck)
+	require.NoError(t, err)
+
+	status := fields[0]
+	require.Equal(t, `"Closed"`, status.DefaultTestValue())
+	require.Equal(t, "types.Status(i % 3)", status.ValueLoop())
+	require.Equal(t, "Status: 1,\n", status.GenesisArgs(4))
+}
+
+func TestFieldCustomGenesisArgs(t *testing.T) {
+	fields, err := ParseFields([]string{"owner:Bar", "items:array.Bar", "byName:map.string.Bar", "byId:map.uint.Bar"}, noCheck)
+	require.NoError(t, err)
+
+	require.Equal(t, "Owner: new(types.Bar),\n", fields[0].GenesisArgs(7))
+	require.Equal(t, "Items: []types.Bar{{}},\n", fields[1].GenesisArgs(7))
+	require.Equal(t, "ByName: map[string]types.Bar{\"7\": {}},\n", fields[2].GenesisArgs(7))
+	require.Equal(t, "ById: map[uint64]types.Bar{7: {}},\n", fields[3].GenesisArgs(7))
+}
@@ -10,6 +10,20 @@ import (
 // Fields represents a Field slice
 type Fields []Field
 
+// RuleTestValue represents the value of a field in a message of the validation rule tests
+type RuleTestValue struct {
+	Field string
+	Value string
+}
+
+// RuleTestCase represents a test case of a field validation rule with the message values
+// and the typed error returned by the message ValidateBasic method
+type RuleTestCase struct {
+	Name   string
+	Values []RuleTestValue
+	Err    string
+}
+
 // GoCLIImports return all go CLI imports
 func (f Fields) GoCLIImports() []datatype.GoImport {
 	allImports := make([]datatype.GoImport, 0)
@@ -26,6 +40,22 @@ func (f Fields) GoCLIImports() []datatype.GoImport {
 	return allImports
 }
 
+// GoTypesImports return all go imports for the types package
+func (f Fields) GoTypesImports() []datatype.GoImport {
+	allImports := make([]datatype.GoImport, 0)
+	exist := make(map[string]struct{})
+	for _, fields := range f {
+		for _, goImport := range fields.GoTypesImports() {
+			if _, ok := exist[goImport.Name]; ok {
+				continue
+			}
+			exist[goImport.Name] = struct{}{}
+			allImports = append(allImports, goImport)
+		}
+	}
+	return allImports
+}
+
 // ProtoImports return all proto imports
 func (f Fields) ProtoImports() []string {
 	allImports := make([]string, 0)
@@ -51,17 +81,107 @@ func (f Fields) String() string {
 	return args
 }
 
-// Custom return a list of custom fields
+// Custom return a list of custom fields, the arrays and maps of custom types and the enums
+// of the module are included since they are defined in their own proto files like the custom types
 func (f Fields) Custom() []string {
 	fields := make([]string, 0)
 	for _, field := range f {
-		if field.DatatypeName == datatype.TypeCustom {
-			dataType, err := multiformatname.NewName(field.Datatype)
-			if err != nil {
-				panic(err)
-			}
-			fields = append(fields, dataType.Snake)
+		customType := field.Datatype
+		switch field.DatatypeName {
+		case datatype.Custom, datatype.CustomSlice, datatype.Enum:
+		case datatype.CustomMap:
+			_, customType = datatype.SplitCustomMap(field.Datatype)
+		default:
+			continue
+		}
+		dataType, err := multiformatname.NewName(customType)
+		if err != nil {
+			panic(err)
 		}
+		fields = append(fields, dataType.Snake)
 	}
 	return fields
 }
+
+// Enums return a list of enum fields
+func (f Fields) Enums() Fields {
+	fields := make(Fields, 0)
+	for _, field := range f {
+		if field.IsEnum() {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// HasDefaultTestSample returns true if the default test value of a field is generated by the sample package
+func (f Fields) HasDefaultTestSample() bool {
+	for _, field := range f {
+		if field.dataType().DefaultTestSample != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRules returns true if a field has validation rules
+func (f Fields) HasRules() bool {
+	for _, field := range f {
+		if field.HasRules() {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleTestValues return the values of the fields with validation rules that satisfy the rules
+func (f Fields) RuleTestValues() []RuleTestValue {
+	values := make([]RuleTestValue, 0)
+	for _, field := range f {
+		if field.HasRules() {
+			values = append(values, RuleTestValue{Field: field.Name.UpperCamel, Value: field.RuleTestValue()})
+		}
+	}
+	return values
+}
+
+// RuleTestCases return a test case for each validation rule of the fields, the field of the rule
+// has a value that doesn't satisfy the rule and the other fields have values that satisfy their rules
+func (f Fields) RuleTestCases() []RuleTestCase {
+	cases := make([]RuleTestCase, 0)
+	for _, field := range f {
+		if !field.HasRules() {
+			continue
+		}
+		for _, invalid := range field.dataType().InvalidTestValues(field.DataType(), field.Rules) {
+			values := f.RuleTestValues()
+			for i := range values {
+				if values[i].Field == field.Name.UpperCamel {
+					values[i].Value = invalid.Value
+				}
+			}
+			cases = append(cases, RuleTestCase{
+				Name:   fmt.Sprintf("invalid %s %s", field.Name.LowerCamel, invalid.Rule),
+				Values: values,
+				Err:    invalid.Err,
+			})
+		}
+	}
+	return cases
+}
+
+// RuleTestImports return all go imports of the validation rule tests
+func (f Fields) RuleTestImports() []datatype.GoImport {
+	allImports := make([]datatype.GoImport, 0)
+	exist := make(map[string]struct{})
+	for _, fields := range f {
+		for _, goImport := range fields.RuleTestImports() {
+			if _, ok := exist[goImport.Name]; ok {
+				continue
+			}
+			exist[goImport.Name] = struct{}{}
+			allImports = append(allImports, goImport)
+		}
+	}
+	return allImports
+}
@@ -1,6 +1,7 @@
 package field
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -11,8 +12,15 @@ import (
 // validateField validates the field Name and type, and checks the name is not forbidden by Ignite CLI
 func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, error) {
 	fieldSplit := strings.Split(field, datatype.Separator)
-	if len(fieldSplit) > 2 {
-		return multiformatname.Name{}, "", fmt.Errorf("invalid field format: %s, should be 'Name' or 'Name:type'", field)
+	maxParts := 3
+	if len(fieldSplit) > 1 && datatype.Name(fieldSplit[1]) == datatype.Enum {
+		maxParts = 4
+	}
+	if len(fieldSplit) > maxParts {
+		return multiformatname.Name{}, "", fmt.Errorf(
+			"invalid field format: %s, should be 'Name', 'Name:type', 'Name:type:rules' or 'Name:enum:Value1|Value2:rules'",
+			field,
+		)
 	}
 
 	name, err := multiformatname.NewName(fieldSplit[0])
@@ -27,13 +35,40 @@ func validateField(field string, isForbiddenField func(string) error) (multiform
 
 	// Check if the object has an explicit type. The default is a string
 	dataTypeName := datatype.String
-	isTypeSpecified := len(fieldSplit) == 2
+	isTypeSpecified := len(fieldSplit) >= 2
 	if isTypeSpecified {
 		dataTypeName = datatype.Name(fieldSplit[1])
 	}
 	return name, dataTypeName, nil
 }
 
+// parseEnumValues parses the values of an enum field with the format 'Name:enum:Value1|Value2'
+func parseEnumValues(field string) ([]multiformatname.Name, error) {
+	fieldSplit := strings.Split(field, datatype.Separator)
+	if len(fieldSplit) < 3 || fieldSplit[2] == "" {
+		return nil, fmt.Errorf("the enum field %s has no values, should be 'Name:enum:Value1|Value2'", fieldSplit[0])
+	}
+
+	var (
+		values []multiformatname.Name
+		exist  = make(map[string]struct{})
+	)
+	for _, v := range strings.Split(fieldSplit[2], datatype.EnumValueSeparator) {
+		value, err := multiformatname.NewName(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %s of the enum field %s: %s", v, fieldSplit[0], err.Error())
+		}
+
+		// The values are case insensitive in the CLI and upper cased in proto
+		if _, ok := exist[value.Snake]; ok {
+			return nil, fmt.Errorf("the value %s of the enum field %s is duplicated", v, fieldSplit[0])
+		}
+		exist[value.Snake] = struct{}{}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
 // ParseFields parses the provided fields, analyses the types
 // and checks there is no duplicated field
 func ParseFields(
@@ -62,20 +97,97 @@ func ParseFields(
 		}
 		existingFields[name.LowerCamel] = struct{}{}
 
-		// Check if is a static type
-		if _, ok := datatype.SupportedTypes[datatypeName]; ok {
-			parsedFields = append(parsedFields, Field{
-				Name:         name,
-				DatatypeName: datatypeName,
-			})
-			continue
+		parsedField := Field{
+			Name:         name,
+			DatatypeName: datatypeName,
+		}
+		_, isStaticType := datatype.SupportedTypes[datatypeName]
+		switch {
+		case datatypeName == datatype.Enum:
+			// The enum type is named after the field
+			values, err := parseEnumValues(field)
+			if err != nil {
+				return parsedFields, err
+			}
+			parsedField.Datatype = name.UpperCamel
+			parsedField.EnumValues = values
+		case isStaticType:
+		case strings.HasPrefix(string(datatypeName), datatype.SlicePrefix):
+			parsedField.Datatype = datatype.CustomType(datatypeName)
+			parsedField.DatatypeName = datatype.CustomSlice
+		case strings.HasPrefix(string(datatypeName), datatype.MapPrefix):
+			mapType := strings.TrimPrefix(string(datatypeName), datatype.MapPrefix)
+			if err := validateCustomMap(mapType); err != nil {
+				return parsedFields, fmt.Errorf("invalid type %s of the field %s: %s", datatypeName, name.Original, err.Error())
+			}
+			parsedField.Datatype = mapType
+			parsedField.DatatypeName = datatype.CustomMap
+		default:
+			parsedField.Datatype = string(datatypeName)
+			parsedField.DatatypeName = datatype.TypeCustom
 		}
 
-		parsedFields = append(parsedFields, Field{
-			Name:         name,
-			Datatype:     string(datatypeName),
-			DatatypeName: datatype.TypeCustom,
-		})
+		// Check the validation rules of the field are supported by its type
+		if parsedField.Rules, err = parseRules(parsedField, field); err != nil {
+			return parsedFields, err
+		}
+		parsedFields = append(parsedFields, parsedField)
 	}
 	return parsedFields, nil
 }
+
+// validateCustomMap validates the key type and the custom type of a custom type map with the format 'key.Type'
+func validateCustomMap(mapType string) error {
+	key, value := datatype.SplitCustomMap(mapType)
+	if !strings.Contains(mapType, ".") || value == "" {
+		return errors.New("should be 'map.key.Type'")
+	}
+	if _, ok := datatype.CustomMapKeys[key]; !ok {
+		return errors.New("the map key type should be string, int, uint, int64 or uint64")
+	}
+	return nil
+}
+
+// parseRules parses the validation rules of a field with the format 'Name:type:rule1,rule2=arg'
+func parseRules(field Field, fieldArg string) ([]datatype.Rule, error) {
+	fieldSplit := strings.Split(fieldArg, datatype.Separator)
+	rulesIndex := 2
+	if field.IsEnum() {
+		rulesIndex = 3
+	}
+	if len(fieldSplit) <= rulesIndex {
+		return nil, nil
+	}
+
+	var (
+		rules []datatype.Rule
+		exist = make(map[datatype.RuleName]struct{})
+		dt    = field.dataType()
+	)
+	for _, r := range strings.Split(fieldSplit[rulesIndex], datatype.RuleSeparator) {
+		ruleName, arg, hasArg := strings.Cut(r, datatype.RuleArgSeparator)
+		rule := datatype.Rule{Name: datatype.RuleName(ruleName), Arg: arg}
+		check, ok := dt.Rules[rule.Name]
+		if !ok {
+			return nil, fmt.Errorf("the rule %s is not supported by the type of the field %s", ruleName, field.Name.Original)
+		}
+		if _, ok := exist[rule.Name]; ok {
+			return nil, fmt.Errorf("the rule %s of the field %s is duplicated", ruleName, field.Name.Original)
+		}
+		exist[rule.Name] = struct{}{}
+
+		switch {
+		case check.ValidateArg == nil && hasArg:
+			return nil, fmt.Errorf("the rule %s of the field %s has no argument", ruleName, field.Name.Original)
+		case check.ValidateArg != nil && !hasArg:
+			return nil, fmt.Errorf("the rule %s of the field %s requires an argument", ruleName, field.Name.Original)
+		case check.ValidateArg != nil:
+			if err := check.ValidateArg(arg); err != nil {
+				return nil, fmt.Errorf("invalid rule %s of the field %s: %s", r, field.Name.Original, err.Error())
+			}
+		}
+		rules = append(rules, rule)
+	}
+	datatype.SortRules(rules)
+	return rules, nil
+}
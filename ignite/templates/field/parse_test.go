@@ -35,6 +35,58 @@ func TestForbiddenParseFields(t *testing.T) {
 	// invalid format
 	_, err = ParseFields([]string{"foo:int:int"}, alwaysInvalid)
 	require.Error(t, err)
+
+	// enum without values
+	_, err = ParseFields([]string{"foo:enum"}, noCheck)
+	require.Error(t, err)
+
+	// duplicated enum value
+	_, err = ParseFields([]string{"foo:enum:Pending|pending"}, noCheck)
+	require.Error(t, err)
+
+	// invalid enum value
+	_, err = ParseFields([]string{"foo:enum:Pending|1"}, noCheck)
+	require.Error(t, err)
+
+	// custom map without key type
+	_, err = ParseFields([]string{"foo:map.Bar"}, noCheck)
+	require.Error(t, err)
+
+	// invalid custom map key type
+	_, err = ParseFields([]string{"foo:map.bool.Bar"}, noCheck)
+	require.Error(t, err)
+
+	// rule not supported by the type
+	_, err = ParseFields([]string{"foo:bool:required"}, noCheck)
+	require.Error(t, err)
+
+	// unknown rule
+	_, err = ParseFields([]string{"foo:string:unique"}, noCheck)
+	require.Error(t, err)
+
+	// duplicated rule
+	_, err = ParseFields([]string{"foo:string:max=1,max=2"}, noCheck)
+	require.Error(t, err)
+
+	// rule without argument
+	_, err = ParseFields([]string{"foo:string:max"}, noCheck)
+	require.Error(t, err)
+
+	// rule with an unexpected argument
+	_, err = ParseFields([]string{"foo:string:required=true"}, noCheck)
+	require.Error(t, err)
+
+	// invalid rule argument
+	_, err = ParseFields([]string{"foo:int:max=foo"}, noCheck)
+	require.Error(t, err)
+
+	// invalid range
+	_, err = ParseFields([]string{"foo:dec:range=1..0"}, noCheck)
+	require.Error(t, err)
+
+	// unsigned minimum of zero
+	_, err = ParseFields([]string{"foo:uint:min=0"}, noCheck)
+	require.Error(t, err)
 }
 
 func TestParseFields1(t *testing.T) {
@@ -46,6 +98,10 @@ func TestParseFields1(t *testing.T) {
 	require.NoError(t, err)
 	name4, err := multiformatname.NewName("foo_foo")
 	require.NoError(t, err)
+	value1, err := multiformatname.NewName("Pending")
+	require.NoError(t, err)
+	value2, err := multiformatname.NewName("InProgress")
+	require.NoError(t, err)
 
 	tests := []struct {
 		name   string
@@ -182,6 +238,135 @@ func TestParseFields1(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test cosmos types",
+			fields: []string{
+				name1.Original + ":address",
+				name2.Original + ":dec",
+				name3.Original + ":timestamp",
+				name4.Original + ":duration",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Address,
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Dec,
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.Timestamp,
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.Duration,
+				},
+			},
+		},
+		{
+			name: "test explicit size types",
+			fields: []string{
+				name1.Original + ":int64",
+				name2.Original + ":uint64",
+				name3.Original + ":bytes",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Int64,
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Uint64,
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.Bytes,
+				},
+			},
+		},
+		{
+			name: "test custom types",
+			fields: []string{
+				name1.Original + ":Bar",
+				name2.Original + ":array.Bar",
+				name3.Original + ":map.string.Bar",
+				name4.Original + ":map.uint64.Bar",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					Datatype:     "Bar",
+					DatatypeName: datatype.Custom,
+				},
+				{
+					Name:         name2,
+					Datatype:     "Bar",
+					DatatypeName: datatype.CustomSlice,
+				},
+				{
+					Name:         name3,
+					Datatype:     "string.Bar",
+					DatatypeName: datatype.CustomMap,
+				},
+				{
+					Name:         name4,
+					Datatype:     "uint64.Bar",
+					DatatypeName: datatype.CustomMap,
+				},
+			},
+		},
+		{
+			name: "test enum types",
+			fields: []string{
+				name2.Original + ":enum:Pending|InProgress",
+			},
+			want: Fields{
+				{
+					Name:         name2,
+					DatatypeName: datatype.Enum,
+					Datatype:     "FooBar",
+					EnumValues:   []multiformatname.Name{value1, value2},
+				},
+			},
+		},
+		{
+			name: "test validation rules",
+			fields: []string{
+				name1.Original + ":string:required,max=64",
+				name2.Original + ":coin:positive",
+				name3.Original + ":dec:range=0..1",
+				name4.Original + ":enum:Pending|InProgress",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.String,
+					Rules: []datatype.Rule{
+						{Name: datatype.RuleRequired},
+						{Name: datatype.RuleMax, Arg: "64"},
+					},
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Coin,
+					Rules:        []datatype.Rule{{Name: datatype.RulePositive}},
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.Dec,
+					Rules:        []datatype.Rule{{Name: datatype.RuleRange, Arg: "0..1"}},
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.Enum,
+					Datatype:     "FooFoo",
+					EnumValues:   []multiformatname.Name{value1, value2},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
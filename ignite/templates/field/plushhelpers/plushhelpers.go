@@ -11,6 +11,7 @@ import (
 // ExtendPlushContext sets available field helpers on the provided context.
 func ExtendPlushContext(ctx *plush.Context) {
 	ctx.Set("mergeGoImports", mergeGoImports)
+	ctx.Set("mergeGoTypesImports", mergeGoTypesImports)
 	ctx.Set("mergeProtoImports", mergeProtoImports)
 	ctx.Set("mergeCustomImports", mergeCustomImports)
 	ctx.Set("title", xstrings.Title)
@@ -46,6 +47,21 @@ func mergeGoImports(fields ...field.Fields) []datatype.GoImport {
 	return allImports
 }
 
+func mergeGoTypesImports(fields ...field.Fields) []datatype.GoImport {
+	allImports := make([]datatype.GoImport, 0)
+	exist := make(map[string]struct{})
+	for _, fields := range fields {
+		for _, goImport := range fields.GoTypesImports() {
+			if _, ok := exist[goImport.Name]; ok {
+				continue
+			}
+			exist[goImport.Name] = struct{}{}
+			allImports = append(allImports, goImport)
+		}
+	}
+	return allImports
+}
+
 func mergeProtoImports(fields ...field.Fields) []string {
 	allImports := make([]string, 0)
 	exist := make(map[string]struct{})
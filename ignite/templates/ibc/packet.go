@@ -18,6 +18,7 @@ import (
 	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
 	"github.com/ignite/cli/ignite/templates/module"
 	"github.com/ignite/cli/ignite/templates/testutil"
+	"github.com/ignite/cli/ignite/templates/typed"
 )
 
 var (
@@ -71,6 +72,9 @@ func NewPacket(replacer placeholder.Replacer, opts *PacketOptions) (*genny.Gener
 		g.RunFn(protoTxModify(replacer, opts))
 		g.RunFn(clientCliTxModify(replacer, opts))
 		g.RunFn(codecModify(replacer, opts))
+		if opts.Fields.HasRules() {
+			g.RunFn(typed.TypesErrorsModify(opts.AppPath, opts.ModuleName))
+		}
 		if err := g.Box(messagesTemplate); err != nil {
 			return g, err
 		}
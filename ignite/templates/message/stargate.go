@@ -20,6 +20,9 @@ func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator
 	g.RunFn(protoTxMessageModify(replacer, opts))
 	g.RunFn(typesCodecModify(replacer, opts))
 	g.RunFn(clientCliTxModify(replacer, opts))
+	if opts.Fields.HasRules() {
+		g.RunFn(typed.TypesErrorsModify(opts.AppPath, opts.ModuleName))
+	}
 
 	template := xgenny.NewEmbedWalker(
 		fsStargateMessage,
@@ -0,0 +1,89 @@
+package typed
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+)
+
+// errorCodeBlockSize is the size of the blocks of error codes of a module
+const errorCodeBlockSize = 100
+
+// TypesErrorsModify returns the run function that adds the typed errors of the field
+// validation rules in the errors of the module when they are not already defined
+func TypesErrorsModify(appPath, moduleName string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(appPath, "x", moduleName, "types/errors.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		if strings.Contains(f.String(), datatype.ErrFieldRequired) {
+			return nil
+		}
+		code, err := nextErrorCodeBlock(path, f.String())
+		if err != nil {
+			return err
+		}
+		content := f.String() + fmt.Sprintf(`
+// x/%[1]v module field validation errors
+var (
+	%[2]v = sdkerrors.Register(ModuleName, %[6]v, "required field")
+	%[3]v = sdkerrors.Register(ModuleName, %[7]v, "field value too small")
+	%[4]v = sdkerrors.Register(ModuleName, %[8]v, "field value too large")
+	%[5]v = sdkerrors.Register(ModuleName, %[9]v, "field value not positive")
+)
+`,
+			moduleName,
+			datatype.ErrFieldRequired,
+			datatype.ErrFieldTooSmall,
+			datatype.ErrFieldTooLarge,
+			datatype.ErrFieldNotPositive,
+			code,
+			code+1,
+			code+2,
+			code+3,
+		)
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// nextErrorCodeBlock returns the first code of the next free block of error codes
+// after the highest code registered in the errors file of a module, e.g. 1200 when
+// the highest registered code is 1101, to not collide with the module's own errors
+func nextErrorCodeBlock(path, content string) (int, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, content, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	var highest int
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || sel.Sel.Name != "Register" {
+			return true
+		}
+		lit, ok := call.Args[1].(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			return true
+		}
+		if code, err := strconv.Atoi(lit.Value); err == nil && code > highest {
+			highest = code
+		}
+		return true
+	})
+
+	return (highest/errorCodeBlockSize + 1) * errorCodeBlockSize, nil
+}
@@ -0,0 +1,77 @@
+package typed
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+)
+
+const testErrorsFile = `package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/mars module sentinel errors
+var (
+	ErrSample    = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrNotFound  = sdkerrors.Register(ModuleName, 1101, "not found")
+	ErrForbidden = sdkerrors.Register(ModuleName, 1102, "forbidden")
+)
+`
+
+func TestTypesErrorsModify(t *testing.T) {
+	path := filepath.Join("mars", "x", "mars", "types/errors.go")
+	r := genny.DryRunner(context.Background())
+	r.Disk.Add(genny.NewFileS(path, testErrorsFile))
+
+	require.NoError(t, TypesErrorsModify("mars", "mars")(r))
+
+	f, err := r.Disk.Find(path)
+	require.NoError(t, err)
+	require.Contains(t, f.String(), `ErrNotFound  = sdkerrors.Register(ModuleName, 1101, "not found")`)
+	require.Contains(t, f.String(), `ErrFieldRequired = sdkerrors.Register(ModuleName, 1200, "required field")`)
+	require.Contains(t, f.String(), `ErrFieldNotPositive = sdkerrors.Register(ModuleName, 1203, "field value not positive")`)
+
+	// the errors are not added twice
+	require.NoError(t, TypesErrorsModify("mars", "mars")(r))
+	f, err = r.Disk.Find(path)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(f.String(), "ErrFieldRequired ="))
+}
+
+func TestNextErrorCodeBlock(t *testing.T) {
+	cases := []struct {
+		name, content string
+		want          int
+	}{
+		{
+			name:    "scaffolded module",
+			content: "package types\n\nvar ErrSample = sdkerrors.Register(ModuleName, 1100, \"sample error\")\n",
+			want:    1200,
+		},
+		{
+			name: "ibc module",
+			content: "package types\n\nvar (\n\tErrSample = sdkerrors.Register(ModuleName, 1100, \"sample error\")\n" +
+				"\tErrInvalidVersion = sdkerrors.Register(ModuleName, 1501, \"invalid version\")\n)\n",
+			want: 1600,
+		},
+		{
+			name:    "no errors",
+			content: "package types\n",
+			want:    100,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := nextErrorCodeBlock("errors.go", tt.content)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, code)
+		})
+	}
+}
@@ -87,6 +87,9 @@ func NewStargate(replacer placeholder.Replacer, opts *typed.Options) (*genny.Gen
 		g.RunFn(protoTxModify(replacer, opts))
 		g.RunFn(clientCliTxModify(replacer, opts))
 		g.RunFn(typesCodecModify(replacer, opts))
+		if opts.Fields.HasRules() {
+			g.RunFn(typed.TypesErrorsModify(opts.AppPath, opts.ModuleName))
+		}
 
 		if !opts.NoSimulation {
 			g.RunFn(moduleSimulationModify(replacer, opts))
@@ -172,8 +175,9 @@ func protoRPCModify(replacer placeholder.Replacer, opts *typed.Options) genny.Ru
 		}
 
 		// Ensure custom types are imported
-		protoImports := opts.Fields.ProtoImports()
-		for _, f := range opts.Fields.Custom() {
+		protoImports := append(opts.Fields.ProtoImports(), opts.Indexes.ProtoImports()...)
+		customFields := append(opts.Fields.Custom(), opts.Indexes.Custom()...)
+		for _, f := range customFields {
 			protoImports = append(protoImports,
 				fmt.Sprintf("%[1]v/%[2]v/%[3]v.proto", opts.AppName, opts.ModuleName, f),
 			)
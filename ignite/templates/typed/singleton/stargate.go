@@ -63,6 +63,9 @@ func NewStargate(replacer placeholder.Replacer, opts *typed.Options) (*genny.Gen
 		g.RunFn(protoTxModify(replacer, opts))
 		g.RunFn(clientCliTxModify(replacer, opts))
 		g.RunFn(typesCodecModify(replacer, opts))
+		if opts.Fields.HasRules() {
+			g.RunFn(typed.TypesErrorsModify(opts.AppPath, opts.ModuleName))
+		}
 
 		if !opts.NoSimulation {
 			g.RunFn(moduleSimulationModify(replacer, opts))